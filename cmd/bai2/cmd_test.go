@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/cobra"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -81,3 +82,28 @@ func TestFormat_ParseError(t *testing.T) {
 	_, err := executeCommand(rootCmd, "format", "--input", parseErrorFileName)
 	assert.Equal(t, err.Error(), "ERROR parsing file on line 1 (unsupported record type 00)")
 }
+
+func TestExport(t *testing.T) {
+	output, err := executeCommand(rootCmd, "export", "--input", testFileName, "--format", "csv", "--table", "details")
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	assert.Contains(t, output, "file_id,group,originator,account,as_of_date,currency,type_code,type_code_name,amount")
+
+	output, err = executeCommand(rootCmd, "export", "--input", testFileName, "--format", "tsv", "--table", "summaries", "--columns", "account,type_code,amount")
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	assert.True(t, strings.HasPrefix(output, "account\ttype_code\tamount\n"))
+
+	_, err = executeCommand(rootCmd, "export", "--input", testFileName, "--format", "xlsx")
+	assert.Equal(t, err.Error(), `unsupported export format "xlsx"`)
+
+	_, err = executeCommand(rootCmd, "export", "--input", testFileName, "--format", "csv", "--table", "groups")
+	assert.Equal(t, err.Error(), `unsupported export table "groups"`)
+}
+
+func TestExport_ParseError(t *testing.T) {
+	_, err := executeCommand(rootCmd, "export", "--input", parseErrorFileName)
+	assert.Equal(t, err.Error(), "ERROR parsing file on line 1 (unsupported record type 00)")
+}
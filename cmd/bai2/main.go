@@ -17,6 +17,7 @@ import (
 
 	"github.com/moov-io/bai2/pkg/lib"
 	"github.com/moov-io/bai2/pkg/service"
+	"github.com/moov-io/bai2/pkg/tabular"
 	baseLog "github.com/moov-io/base/log"
 )
 
@@ -132,6 +133,52 @@ var Format = &cobra.Command{
 	},
 }
 
+var Export = &cobra.Command{
+	Use:   "export",
+	Short: "Export bai2 report",
+	Long:  "Export the transaction details or account summaries of an incoming bai2 report after parse",
+	RunE: func(cmd *cobra.Command, args []string) error {
+
+		var err error
+
+		scan := lib.NewBai2Scanner(bytes.NewReader(documentBuffer))
+		f := lib.NewBai2()
+		err = f.Read(&scan)
+		if err != nil {
+			return err
+		}
+
+		err = f.Validate()
+		if err != nil {
+			return err
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		table, _ := cmd.Flags().GetString("table")
+		columns, _ := cmd.Flags().GetStringSlice("columns")
+
+		var opts []tabular.Option
+		switch format {
+		case "csv":
+		case "tsv":
+			opts = append(opts, tabular.WithDelimiter(tabular.Tab))
+		default:
+			return fmt.Errorf("unsupported export format %q", format)
+		}
+		if len(columns) > 0 {
+			opts = append(opts, tabular.WithColumns(columns...))
+		}
+
+		switch table {
+		case "details":
+			return tabular.WriteDetails(cmd.OutOrStdout(), f, opts...)
+		case "summaries":
+			return tabular.WriteSummaries(cmd.OutOrStdout(), f, opts...)
+		}
+		return fmt.Errorf("unsupported export table %q", table)
+	},
+}
+
 var rootCmd = &cobra.Command{
 	Use:   "",
 	Short: "",
@@ -178,6 +225,9 @@ var rootCmd = &cobra.Command{
 
 func initRootCmd() {
 	WebCmd.Flags().BoolP("test", "t", false, "test server")
+	Export.Flags().String("format", "csv", "export format: csv or tsv")
+	Export.Flags().String("table", "details", "exported table: details or summaries")
+	Export.Flags().StringSlice("columns", nil, "exported columns, rather than the default columns of the table")
 
 	rootCmd.SilenceUsage = true
 	rootCmd.PersistentFlags().StringVar(&documentFileName, "input", "", "bai2 report file")
@@ -185,6 +235,7 @@ func initRootCmd() {
 	rootCmd.AddCommand(Print)
 	rootCmd.AddCommand(Parse)
 	rootCmd.AddCommand(Format)
+	rootCmd.AddCommand(Export)
 }
 
 func main() {
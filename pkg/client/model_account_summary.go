@@ -21,6 +21,7 @@ var _ MappedNullable = &AccountSummary{}
 type AccountSummary struct {
 	TypeCode  *string    `json:"TypeCode,omitempty"`
 	Amount    *string    `json:"Amount,omitempty"`
+	Money     *Money     `json:"money,omitempty"`
 	ItemCount *int32     `json:"ItemCount,omitempty"`
 	FundsType *FundsType `json:"FundsType,omitempty"`
 }
@@ -106,6 +107,38 @@ func (o *AccountSummary) SetAmount(v string) {
 	o.Amount = &v
 }
 
+// GetMoney returns the Money field value if set, zero value otherwise.
+func (o *AccountSummary) GetMoney() Money {
+	if o == nil || IsNil(o.Money) {
+		var ret Money
+		return ret
+	}
+	return *o.Money
+}
+
+// GetMoneyOk returns a tuple with the Money field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *AccountSummary) GetMoneyOk() (*Money, bool) {
+	if o == nil || IsNil(o.Money) {
+		return nil, false
+	}
+	return o.Money, true
+}
+
+// HasMoney returns a boolean if a field has been set.
+func (o *AccountSummary) HasMoney() bool {
+	if o != nil && !IsNil(o.Money) {
+		return true
+	}
+
+	return false
+}
+
+// SetMoney gets a reference to the given Money and assigns it to the Money field.
+func (o *AccountSummary) SetMoney(v Money) {
+	o.Money = &v
+}
+
 // GetItemCount returns the ItemCount field value if set, zero value otherwise.
 func (o *AccountSummary) GetItemCount() int32 {
 	if o == nil || IsNil(o.ItemCount) {
@@ -186,6 +219,9 @@ func (o AccountSummary) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Amount) {
 		toSerialize["Amount"] = o.Amount
 	}
+	if !IsNil(o.Money) {
+		toSerialize["money"] = o.Money
+	}
 	if !IsNil(o.ItemCount) {
 		toSerialize["ItemCount"] = o.ItemCount
 	}
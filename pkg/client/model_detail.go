@@ -21,6 +21,7 @@ var _ MappedNullable = &Detail{}
 type Detail struct {
 	TypeCode                *string    `json:"TypeCode,omitempty"`
 	Amount                  *string    `json:"Amount,omitempty"`
+	Money                   *Money     `json:"money,omitempty"`
 	FundsType               *FundsType `json:"FundsType,omitempty"`
 	BankReferenceNumber     *string    `json:"BankReferenceNumber,omitempty"`
 	CustomerReferenceNumber *string    `json:"CustomerReferenceNumber,omitempty"`
@@ -108,6 +109,38 @@ func (o *Detail) SetAmount(v string) {
 	o.Amount = &v
 }
 
+// GetMoney returns the Money field value if set, zero value otherwise.
+func (o *Detail) GetMoney() Money {
+	if o == nil || IsNil(o.Money) {
+		var ret Money
+		return ret
+	}
+	return *o.Money
+}
+
+// GetMoneyOk returns a tuple with the Money field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Detail) GetMoneyOk() (*Money, bool) {
+	if o == nil || IsNil(o.Money) {
+		return nil, false
+	}
+	return o.Money, true
+}
+
+// HasMoney returns a boolean if a field has been set.
+func (o *Detail) HasMoney() bool {
+	if o != nil && !IsNil(o.Money) {
+		return true
+	}
+
+	return false
+}
+
+// SetMoney gets a reference to the given Money and assigns it to the Money field.
+func (o *Detail) SetMoney(v Money) {
+	o.Money = &v
+}
+
 // GetFundsType returns the FundsType field value if set, zero value otherwise.
 func (o *Detail) GetFundsType() FundsType {
 	if o == nil || IsNil(o.FundsType) {
@@ -252,6 +285,9 @@ func (o Detail) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Amount) {
 		toSerialize["Amount"] = o.Amount
 	}
+	if !IsNil(o.Money) {
+		toSerialize["money"] = o.Money
+	}
 	if !IsNil(o.FundsType) {
 		toSerialize["FundsType"] = o.FundsType
 	}
@@ -21,6 +21,7 @@ var _ MappedNullable = &Distribution{}
 type Distribution struct {
 	Day    *int32 `json:"day,omitempty"`
 	Amount *int32 `json:"amount,omitempty"`
+	Money  *Money `json:"money,omitempty"`
 }
 
 // NewDistribution instantiates a new Distribution object
@@ -104,6 +105,38 @@ func (o *Distribution) SetAmount(v int32) {
 	o.Amount = &v
 }
 
+// GetMoney returns the Money field value if set, zero value otherwise.
+func (o *Distribution) GetMoney() Money {
+	if o == nil || IsNil(o.Money) {
+		var ret Money
+		return ret
+	}
+	return *o.Money
+}
+
+// GetMoneyOk returns a tuple with the Money field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Distribution) GetMoneyOk() (*Money, bool) {
+	if o == nil || IsNil(o.Money) {
+		return nil, false
+	}
+	return o.Money, true
+}
+
+// HasMoney returns a boolean if a field has been set.
+func (o *Distribution) HasMoney() bool {
+	if o != nil && !IsNil(o.Money) {
+		return true
+	}
+
+	return false
+}
+
+// SetMoney gets a reference to the given Money and assigns it to the Money field.
+func (o *Distribution) SetMoney(v Money) {
+	o.Money = &v
+}
+
 func (o Distribution) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -120,6 +153,9 @@ func (o Distribution) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Amount) {
 		toSerialize["amount"] = o.Amount
 	}
+	if !IsNil(o.Money) {
+		toSerialize["money"] = o.Money
+	}
 	return toSerialize, nil
 }
 
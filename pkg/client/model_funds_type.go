@@ -23,8 +23,11 @@ type FundsType struct {
 	Date               *string        `json:"date,omitempty"`
 	Time               *string        `json:"time,omitempty"`
 	ImmediateAmount    *string        `json:"immediate_amount,omitempty"`
+	ImmediateMoney     *Money         `json:"immediate_money,omitempty"`
 	OneDayAmount       *string        `json:"one_day_amount,omitempty"`
+	OneDayMoney        *Money         `json:"one_day_money,omitempty"`
 	TwoDayAmount       *string        `json:"two_day_amount,omitempty"`
+	TwoDayMoney        *Money         `json:"two_day_money,omitempty"`
 	DistributionNumber *int32         `json:"distribution_number,omitempty"`
 	Distributions      []Distribution `json:"distributions,omitempty"`
 }
@@ -174,6 +177,38 @@ func (o *FundsType) SetImmediateAmount(v string) {
 	o.ImmediateAmount = &v
 }
 
+// GetImmediateMoney returns the ImmediateMoney field value if set, zero value otherwise.
+func (o *FundsType) GetImmediateMoney() Money {
+	if o == nil || IsNil(o.ImmediateMoney) {
+		var ret Money
+		return ret
+	}
+	return *o.ImmediateMoney
+}
+
+// GetImmediateMoneyOk returns a tuple with the ImmediateMoney field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *FundsType) GetImmediateMoneyOk() (*Money, bool) {
+	if o == nil || IsNil(o.ImmediateMoney) {
+		return nil, false
+	}
+	return o.ImmediateMoney, true
+}
+
+// HasImmediateMoney returns a boolean if a field has been set.
+func (o *FundsType) HasImmediateMoney() bool {
+	if o != nil && !IsNil(o.ImmediateMoney) {
+		return true
+	}
+
+	return false
+}
+
+// SetImmediateMoney gets a reference to the given Money and assigns it to the ImmediateMoney field.
+func (o *FundsType) SetImmediateMoney(v Money) {
+	o.ImmediateMoney = &v
+}
+
 // GetOneDayAmount returns the OneDayAmount field value if set, zero value otherwise.
 func (o *FundsType) GetOneDayAmount() string {
 	if o == nil || IsNil(o.OneDayAmount) {
@@ -206,6 +241,38 @@ func (o *FundsType) SetOneDayAmount(v string) {
 	o.OneDayAmount = &v
 }
 
+// GetOneDayMoney returns the OneDayMoney field value if set, zero value otherwise.
+func (o *FundsType) GetOneDayMoney() Money {
+	if o == nil || IsNil(o.OneDayMoney) {
+		var ret Money
+		return ret
+	}
+	return *o.OneDayMoney
+}
+
+// GetOneDayMoneyOk returns a tuple with the OneDayMoney field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *FundsType) GetOneDayMoneyOk() (*Money, bool) {
+	if o == nil || IsNil(o.OneDayMoney) {
+		return nil, false
+	}
+	return o.OneDayMoney, true
+}
+
+// HasOneDayMoney returns a boolean if a field has been set.
+func (o *FundsType) HasOneDayMoney() bool {
+	if o != nil && !IsNil(o.OneDayMoney) {
+		return true
+	}
+
+	return false
+}
+
+// SetOneDayMoney gets a reference to the given Money and assigns it to the OneDayMoney field.
+func (o *FundsType) SetOneDayMoney(v Money) {
+	o.OneDayMoney = &v
+}
+
 // GetTwoDayAmount returns the TwoDayAmount field value if set, zero value otherwise.
 func (o *FundsType) GetTwoDayAmount() string {
 	if o == nil || IsNil(o.TwoDayAmount) {
@@ -238,6 +305,38 @@ func (o *FundsType) SetTwoDayAmount(v string) {
 	o.TwoDayAmount = &v
 }
 
+// GetTwoDayMoney returns the TwoDayMoney field value if set, zero value otherwise.
+func (o *FundsType) GetTwoDayMoney() Money {
+	if o == nil || IsNil(o.TwoDayMoney) {
+		var ret Money
+		return ret
+	}
+	return *o.TwoDayMoney
+}
+
+// GetTwoDayMoneyOk returns a tuple with the TwoDayMoney field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *FundsType) GetTwoDayMoneyOk() (*Money, bool) {
+	if o == nil || IsNil(o.TwoDayMoney) {
+		return nil, false
+	}
+	return o.TwoDayMoney, true
+}
+
+// HasTwoDayMoney returns a boolean if a field has been set.
+func (o *FundsType) HasTwoDayMoney() bool {
+	if o != nil && !IsNil(o.TwoDayMoney) {
+		return true
+	}
+
+	return false
+}
+
+// SetTwoDayMoney gets a reference to the given Money and assigns it to the TwoDayMoney field.
+func (o *FundsType) SetTwoDayMoney(v Money) {
+	o.TwoDayMoney = &v
+}
+
 // GetDistributionNumber returns the DistributionNumber field value if set, zero value otherwise.
 func (o *FundsType) GetDistributionNumber() int32 {
 	if o == nil || IsNil(o.DistributionNumber) {
@@ -324,12 +423,21 @@ func (o FundsType) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.ImmediateAmount) {
 		toSerialize["immediate_amount"] = o.ImmediateAmount
 	}
+	if !IsNil(o.ImmediateMoney) {
+		toSerialize["immediate_money"] = o.ImmediateMoney
+	}
 	if !IsNil(o.OneDayAmount) {
 		toSerialize["one_day_amount"] = o.OneDayAmount
 	}
+	if !IsNil(o.OneDayMoney) {
+		toSerialize["one_day_money"] = o.OneDayMoney
+	}
 	if !IsNil(o.TwoDayAmount) {
 		toSerialize["two_day_amount"] = o.TwoDayAmount
 	}
+	if !IsNil(o.TwoDayMoney) {
+		toSerialize["two_day_money"] = o.TwoDayMoney
+	}
 	if !IsNil(o.DistributionNumber) {
 		toSerialize["distribution_number"] = o.DistributionNumber
 	}
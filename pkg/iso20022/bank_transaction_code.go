@@ -0,0 +1,179 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/moov-io/bai2/pkg/lib"
+	"github.com/moov-io/bai2/pkg/util"
+)
+
+/*
+
+BANK TRANSACTION CODES
+
+Entries and totals carry the BAI2 type code as a proprietary bank transaction code issued by BAI, and
+the ISO bank transaction code (domain, family and sub-family) of the type code:
+
+	Type code                          Domain  Family  Sub-family
+	108 Credit (Any Type)              PMNT    MCOP    OTHR
+	115 Lockbox Deposit                PMNT    LBOX    LBDP
+	140 Total ACH Credits              PMNT    RCDT    ACDT
+	142 ACH Credit Received            PMNT    RCDT    ACDT
+	145 ACH Concentration Credit       PMNT    RCDT    ACON
+	165 Preauthorized ACH Credit       PMNT    RCDT    APAC
+	175 Check Deposit Package          PMNT    RCHQ    CCHQ
+	195 Incoming Money Transfer        PMNT    RCDT    DMCT
+	206 Book Transfer Credit           PMNT    RCDT    BOOK
+	275 ZBA Credit                     CAMT    ACCB    ZABA
+	301 Commercial Deposit             PMNT    CNTR    CDPT
+	354 Interest Credit                ACMT    MCOP    INTR
+	399 Miscellaneous Credit           PMNT    MCOP    OTHR
+	450 Total ACH Debits               PMNT    RDDT    ADBT
+	451 ACH Debit Received             PMNT    RDDT    ADBT
+	455 Preauthorized ACH Debit        PMNT    RDDT    APAC
+	475 Check Paid                     PMNT    ICHQ    CCHQ
+	495 Outgoing Money Transfer        PMNT    ICDT    DMCT
+	506 Book Transfer Debit            PMNT    ICDT    BOOK
+	575 ZBA Debit                      CAMT    ACCB    ZABA
+	698 Miscellaneous Fees             ACMT    MDOP    CHRG
+	699 Miscellaneous Debit            PMNT    MDOP    OTHR
+
+Other credit type codes are reported as miscellaneous credit operations (PMNT MCOP OTHR), and other debit
+type codes as miscellaneous debit operations (PMNT MDOP OTHR).
+
+Entries are given the type code of their proprietary bank transaction code when it is issued by BAI, and
+otherwise the detail type code of the table with their ISO bank transaction code and direction, the highest
+code being used when several match. Other entries are miscellaneous credits (399) or debits (699).
+
+*/
+
+// BAI is the issuer of the proprietary bank transaction codes that hold BAI2 type codes
+const BAI = "BAI"
+
+// BankTransactionCode identifies the kind of an entry by an ISO domain, family and sub-family, and by a
+// proprietary code
+type BankTransactionCode struct {
+	Domain    string `xml:"Domn>Cd,omitempty"`
+	Family    string `xml:"Domn>Fmly>Cd,omitempty"`
+	SubFamily string `xml:"Domn>Fmly>SubFmlyCd,omitempty"`
+
+	Proprietary string `xml:"Prtry>Cd,omitempty"`
+	Issuer      string `xml:"Prtry>Issr,omitempty"`
+}
+
+var (
+	miscellaneousCredit = BankTransactionCode{Domain: "PMNT", Family: "MCOP", SubFamily: "OTHR"}
+	miscellaneousDebit  = BankTransactionCode{Domain: "PMNT", Family: "MDOP", SubFamily: "OTHR"}
+)
+
+// bankTransactionCodes maps BAI2 type codes to ISO bank transaction codes
+var bankTransactionCodes = map[string]BankTransactionCode{
+	lib.TypeCodeCreditAnyType:          miscellaneousCredit,
+	lib.TypeCodeLockboxDeposit:         {Domain: "PMNT", Family: "LBOX", SubFamily: "LBDP"},
+	"140":                              {Domain: "PMNT", Family: "RCDT", SubFamily: "ACDT"},
+	lib.TypeCodeACHCreditReceived:      {Domain: "PMNT", Family: "RCDT", SubFamily: "ACDT"},
+	"145":                              {Domain: "PMNT", Family: "RCDT", SubFamily: "ACON"},
+	lib.TypeCodePreauthorizedACHCredit: {Domain: "PMNT", Family: "RCDT", SubFamily: "APAC"},
+	"175":                              {Domain: "PMNT", Family: "RCHQ", SubFamily: "CCHQ"},
+	lib.TypeCodeIncomingMoneyTransfer:  {Domain: "PMNT", Family: "RCDT", SubFamily: "DMCT"},
+	"206":                              {Domain: "PMNT", Family: "RCDT", SubFamily: "BOOK"},
+	"275":                              {Domain: "CAMT", Family: "ACCB", SubFamily: "ZABA"},
+	"301":                              {Domain: "PMNT", Family: "CNTR", SubFamily: "CDPT"},
+	"354":                              {Domain: "ACMT", Family: "MCOP", SubFamily: "INTR"},
+	lib.TypeCodeMiscellaneousCredit:    miscellaneousCredit,
+	"450":                              {Domain: "PMNT", Family: "RDDT", SubFamily: "ADBT"},
+	lib.TypeCodeACHDebitReceived:       {Domain: "PMNT", Family: "RDDT", SubFamily: "ADBT"},
+	lib.TypeCodePreauthorizedACHDebit:  {Domain: "PMNT", Family: "RDDT", SubFamily: "APAC"},
+	lib.TypeCodeCheckPaid:              {Domain: "PMNT", Family: "ICHQ", SubFamily: "CCHQ"},
+	lib.TypeCodeOutgoingMoneyTransfer:  {Domain: "PMNT", Family: "ICDT", SubFamily: "DMCT"},
+	"506":                              {Domain: "PMNT", Family: "ICDT", SubFamily: "BOOK"},
+	"575":                              {Domain: "CAMT", Family: "ACCB", SubFamily: "ZABA"},
+	"698":                              {Domain: "ACMT", Family: "MDOP", SubFamily: "CHRG"},
+	lib.TypeCodeMiscellaneousDebit:     miscellaneousDebit,
+}
+
+// LookupBankTransactionCode returns the bank transaction code of a BAI2 type code with its direction, which
+// holds the type code as proprietary code. It is not found for type codes that are neither credits nor debits.
+func LookupBankTransactionCode(typeCode string, direction lib.TransactionDirection) (BankTransactionCode, bool) {
+	code, ok := bankTransactionCodes[typeCode]
+	if !ok {
+		switch direction {
+		case lib.DirectionCredit:
+			code = miscellaneousCredit
+		case lib.DirectionDebit:
+			code = miscellaneousDebit
+		default:
+			return BankTransactionCode{}, false
+		}
+	}
+
+	code.Proprietary = typeCode
+	code.Issuer = BAI
+	return code, true
+}
+
+// directedCode is an ISO bank transaction code with the direction of its entries
+type directedCode struct {
+	domain, family, subFamily string
+	direction                 lib.TransactionDirection
+}
+
+// detailTypeCodes maps ISO bank transaction codes to the highest detail type code of bankTransactionCodes
+var detailTypeCodes = func() map[directedCode]string {
+	codes := make([]string, 0, len(bankTransactionCodes))
+	for code := range bankTransactionCodes {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	typeCodes := make(map[directedCode]string)
+	for _, code := range codes {
+		definition, ok := lib.LookupTypeCode(code)
+		if !ok || definition.Category != lib.CategoryDetail {
+			continue
+		}
+		c := bankTransactionCodes[code]
+		typeCodes[directedCode{c.Domain, c.Family, c.SubFamily, definition.Direction}] = code
+	}
+	return typeCodes
+}()
+
+// TypeCode returns the BAI2 detail type code of an entry of the originator with the bank transaction code
+// and direction
+func (c BankTransactionCode) TypeCode(originator string, direction lib.TransactionDirection) string {
+	return c.typeCode(lib.CustomTypeCodes, originator, direction)
+}
+
+// typeCode returns the BAI2 detail type code of an entry of the originator, whose customized type codes are
+// held by the registry
+func (c BankTransactionCode) typeCode(typeCodes *lib.TypeCodeRegistry, originator string, direction lib.TransactionDirection) string {
+	if c.baiTypeCode(typeCodes, originator, util.TransactionDetailCode) && typeCodes.Direction(originator, c.Proprietary) == direction {
+		return c.Proprietary
+	}
+
+	if code, ok := detailTypeCodes[directedCode{c.Domain, c.Family, c.SubFamily, direction}]; ok {
+		return code
+	}
+
+	if direction == lib.DirectionDebit {
+		return lib.TypeCodeMiscellaneousDebit
+	}
+	return lib.TypeCodeMiscellaneousCredit
+}
+
+// baiTypeCode reports whether the proprietary code is a BAI2 type code of the originator that may be used in
+// records with the record code
+func (c BankTransactionCode) baiTypeCode(typeCodes *lib.TypeCodeRegistry, originator, recordCode string) bool {
+	if !strings.EqualFold(c.Issuer, BAI) || !util.ValidateTypeCode(c.Proprietary) {
+		return false
+	}
+	if definition, ok := typeCodes.Lookup(originator, c.Proprietary); ok {
+		return definition.AllowedIn(recordCode)
+	}
+	return true
+}
@@ -0,0 +1,62 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/moov-io/bai2/pkg/lib"
+)
+
+/*
+
+CAMT.052 ACCOUNT REPORTS
+
+Groups of same-day data, as told by their as-of-date modifier, are reported during the day by
+camt.052 BankToCustomerAccountReport messages. Every account of these groups is reported as a report that
+holds the information of a camt.053 statement, with:
+
+	ElctrncSeqNb  the file identification number when it is a number, which orders the reports sent for
+	              an account during the day
+	FrToDt        from the start of the as-of date to the as-of date and time of the group
+	Bal           balances dated with the as-of date and time of the group
+
+*/
+
+// NewAccountReport returns the camt.052 message reporting every account of the groups of intraday data
+func NewAccountReport(file *lib.Bai2) (*Document, error) {
+	created, err := file.FileCreated()
+	if err != nil {
+		return nil, err
+	}
+
+	reports, err := newStatements(file, created, true)
+	if err != nil {
+		return nil, err
+	}
+
+	message := &BankToCustomerAccountReport{
+		GrpHdr: newGroupHeader(file, created),
+		Rpt:    reports,
+	}
+	return &Document{Xmlns: Camt052Namespace, BkToCstmrAcctRpt: message}, nil
+}
+
+// intradayReport sets the sequence number, period and balance dates of the report of an intraday group
+func intradayReport(report *Statement, file *lib.Bai2, group *lib.Group, day, asOf time.Time) {
+	if sequence, err := strconv.ParseUint(file.FileIdNumber, 10, 64); err == nil {
+		report.ElctrncSeqNb = strconv.FormatUint(sequence, 10)
+	}
+
+	if group.AsOfTime == "" {
+		return
+	}
+
+	report.FrToDt = &DateTimePeriod{FrDtTm: isoDateTime(day), ToDtTm: isoDateTime(asOf)}
+	for i := range report.Bal {
+		report.Bal[i].Dt = DateAndDateTime{DtTm: isoDateTime(asOf)}
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/bai2/pkg/lib"
+)
+
+func TestNewAccountReport(t *testing.T) {
+	eastern, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	zones := lib.NewTimeZoneRegistry()
+	zones.Register("0004", eastern)
+
+	file, err := lib.NewFileBuilder().
+		Registries(lib.UseTimeZones(zones)).
+		Sender("0004").
+		Receiver("12345").
+		Created(time.Date(2006, time.March, 17, 11, 45, 0, 0, eastern)).
+		FileID("003").
+		Group("0004", time.Date(2006, time.March, 16, 0, 0, 0, 0, eastern)).
+		AsOfDateModifier(lib.AsOfFinalPreviousDay).
+		Account("10200123456").
+		Summary(lib.TypeCodeClosingLedger, 100000, 0).
+		Group("0004", time.Date(2006, time.March, 17, 11, 30, 0, 0, eastern)).
+		AsOfDateModifier(lib.AsOfInterimSameDay).
+		Account("10200123456").
+		Summary(lib.TypeCodeCurrentAvailable, 120000, 0).
+		Credit(lib.TypeCodeIncomingMoneyTransfer, 20000, lib.WithBankReference("FW1")).
+		Build()
+	require.NoError(t, err)
+
+	doc, err := NewAccountReport(file)
+	require.NoError(t, err)
+
+	data, err := doc.XML()
+	require.NoError(t, err)
+	require.Contains(t, string(data), `<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.052.001.08">`)
+
+	var read Document
+	require.NoError(t, xml.Unmarshal(data, &read))
+	require.Nil(t, read.BkToCstmrStmt)
+
+	message := read.BkToCstmrAcctRpt
+	require.NotNil(t, message)
+	require.Equal(t, "003", message.GrpHdr.MsgId)
+	require.Equal(t, "2006-03-17T11:45:00-05:00", message.GrpHdr.CreDtTm)
+
+	// only the intraday group is reported
+	require.Len(t, message.Rpt, 1)
+	report := message.Rpt[0]
+	require.Equal(t, "003-2-1", report.Id)
+	require.Equal(t, "3", report.ElctrncSeqNb)
+	require.Equal(t, &DateTimePeriod{FrDtTm: "2006-03-17T00:00:00-05:00", ToDtTm: "2006-03-17T11:30:00-05:00"}, report.FrToDt)
+	require.Equal(t, []Balance{{
+		Cd:        "ITAV",
+		Amt:       Amount{Value: "1200.00", Ccy: "USD"},
+		CdtDbtInd: Credit,
+		Dt:        DateAndDateTime{DtTm: "2006-03-17T11:30:00-05:00"},
+	}}, report.Bal)
+	require.Len(t, report.Ntry, 1)
+	require.Equal(t, "FW1", report.Ntry[0].AcctSvcrRef)
+	require.Equal(t, &DateAndDateTime{Dt: "2006-03-17"}, report.Ntry[0].BookgDt)
+
+	// and the end-of-day group is reported by the statement
+	doc, err = NewStatement(file)
+	require.NoError(t, err)
+	require.Len(t, doc.BkToCstmrStmt.Stmt, 1)
+	require.Equal(t, "003-1-1", doc.BkToCstmrStmt.Stmt[0].Id)
+	require.Empty(t, doc.BkToCstmrStmt.Stmt[0].ElctrncSeqNb)
+
+	// file identification numbers that are not numbers do not sequence the reports
+	file.FileIdNumber = "A1"
+	doc, err = NewAccountReport(file)
+	require.NoError(t, err)
+	require.Empty(t, doc.BkToCstmrAcctRpt.Rpt[0].ElctrncSeqNb)
+}
@@ -0,0 +1,376 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/moov-io/bai2/pkg/lib"
+)
+
+/*
+
+CAMT.053 STATEMENTS
+
+Every account of the end-of-day groups of a file is reported as a statement of a camt.053
+BankToCustomerStatement message:
+
+	File header         GrpHdr: the file identification number as MsgId, the file creation date and time
+	                    as CreDtTm, the receiver as MsgRcpt and the sender as AddtlInf
+	Group header        the originator as the servicer of the accounts, and the as-of date as the date of
+	                    balances and the booking date of entries
+	Account identifier  Stmt: the account number and effective currency as Acct
+	Status type codes   Bal: 010 OPBD, 015 CLBD, 030 ITBD, 040 OPAV, 045 CLAV and 060 ITAV, other status type
+	                    codes as proprietary balance types. Every statement has both OPBD and CLBD: when the
+	                    group reports a single ledger, the missing balance is computed with the net amount of
+	                    the booked entries.
+	Summary type codes  TxsSummry: 100 as TtlCdtNtries, 400 as TtlDbtNtries, other summaries as
+	                    TtlNtriesPerBkTxCd
+	Transaction detail  Ntry: the bank reference number as AcctSvcrRef, the customer reference number as
+	                    EndToEndId, the text as AddtlNtryInf, value dated funds types as ValDt and other funds
+	                    types as Avlbty
+
+Transaction details that are neither credits nor debits, such as non-monetary information, are not reported.
+Accounts of end-of-day groups without an opening or closing ledger cannot be reported. Groups of intraday data
+are reported by NewAccountReport.
+
+*/
+
+// balanceTypes maps BAI2 status type codes to ISO balance type codes
+var balanceTypes = map[string]string{
+	lib.TypeCodeOpeningLedger:    "OPBD",
+	lib.TypeCodeClosingLedger:    "CLBD",
+	lib.TypeCodeCurrentLedger:    "ITBD",
+	lib.TypeCodeOpeningAvailable: "OPAV",
+	lib.TypeCodeClosingAvailable: "CLAV",
+	lib.TypeCodeCurrentAvailable: "ITAV",
+}
+
+// NewStatement returns the camt.053 message reporting every account of the groups of end-of-day data
+func NewStatement(file *lib.Bai2) (*Document, error) {
+	created, err := file.FileCreated()
+	if err != nil {
+		return nil, err
+	}
+
+	statements, err := newStatements(file, created, false)
+	if err != nil {
+		return nil, err
+	}
+
+	message := &BankToCustomerStatement{
+		GrpHdr: newGroupHeader(file, created),
+		Stmt:   statements,
+	}
+	return &Document{Xmlns: Camt053Namespace, BkToCstmrStmt: message}, nil
+}
+
+// newStatements returns the statements of the accounts of the intraday groups of the file, or of its other groups
+func newStatements(file *lib.Bai2, created time.Time, intraday bool) ([]Statement, error) {
+	var statements []Statement
+
+	for i := range file.Groups {
+		group := &file.Groups[i]
+		if group.AsOfDateModifier.Intraday() != intraday {
+			continue
+		}
+
+		asOf, err := group.AsOf()
+		if err != nil {
+			return nil, fmt.Errorf("%v in group %d", err, i+1)
+		}
+		// an end of day as-of time still dates the statement on the as-of date
+		day, err := group.AsOfDay()
+		if err != nil {
+			return nil, fmt.Errorf("%v in group %d", err, i+1)
+		}
+
+		for j := range group.Accounts {
+			statement, err := newStatement(group, &group.Accounts[j], day, intraday)
+			if err != nil {
+				return nil, fmt.Errorf("%v in group %d", err, i+1)
+			}
+			statement.Id = fmt.Sprintf("%s-%d-%d", file.FileIdNumber, i+1, j+1)
+			statement.CreDtTm = isoDateTime(created)
+			if intraday {
+				intradayReport(&statement, file, group, day, asOf)
+			}
+
+			statements = append(statements, statement)
+		}
+	}
+
+	return statements, nil
+}
+
+func newGroupHeader(file *lib.Bai2, created time.Time) GroupHeader {
+	return GroupHeader{
+		MsgId:    file.FileIdNumber,
+		CreDtTm:  isoDateTime(created),
+		MsgRcpt:  file.Receiver,
+		AddtlInf: file.Sender,
+	}
+}
+
+// newStatement returns the account, balances, totals and entries of the statement of an account. Statements
+// that are not intraday reports have both an opening and a closing booked balance.
+func newStatement(group *lib.Group, account *lib.Account, asOf time.Time, intraday bool) (Statement, error) {
+	currency := account.EffectiveCurrency(group)
+
+	statement := Statement{
+		Acct: CashAccount{
+			Othr:      account.AccountNumber,
+			Ccy:       currency,
+			SvcrMmbId: group.Originator,
+		},
+	}
+
+	var summary TransactionsSummary
+	for _, s := range account.Summaries {
+		if s.TypeCode == "" || s.Amount == "" {
+			continue
+		}
+
+		amount, err := s.Money(currency)
+		if err != nil {
+			return Statement{}, fmt.Errorf("%v for account %s", err, account.AccountNumber)
+		}
+
+		if isStatus(group.LookupTypeCode, s.TypeCode) {
+			statement.Bal = append(statement.Bal, newBalance(s.TypeCode, amount, asOf))
+			continue
+		}
+
+		direction := group.TypeCodeDirection(s.TypeCode)
+		totals := NumberAndSum{Sum: newAmount(amount).Value}
+		if s.ItemCount > 0 {
+			totals.NbOfNtries = strconv.FormatInt(s.ItemCount, 10)
+		}
+
+		switch {
+		case s.TypeCode == lib.TypeCodeTotalCredits:
+			summary.TtlCdtNtries = &totals
+		case s.TypeCode == lib.TypeCodeTotalDebits:
+			summary.TtlDbtNtries = &totals
+		default:
+			code, ok := LookupBankTransactionCode(s.TypeCode, direction)
+			if !ok {
+				continue
+			}
+			summary.TtlNtriesPerBkTxCd = append(summary.TtlNtriesPerBkTxCd, TotalsPerBankTransactionCode{
+				NbOfNtries: totals.NbOfNtries,
+				Sum:        totals.Sum,
+				CdtDbtInd:  indicator(direction, amount),
+				BkTxCd:     code,
+			})
+		}
+	}
+	if summary.TtlCdtNtries != nil || summary.TtlDbtNtries != nil || len(summary.TtlNtriesPerBkTxCd) > 0 {
+		statement.TxsSummry = &summary
+	}
+
+	net := lib.NewMoney(0, currency)
+	for i := range account.Details {
+		entry, ok, err := newEntry(&account.Details[i], group, currency, asOf)
+		if err != nil {
+			return Statement{}, fmt.Errorf("%v for account %s", err, account.AccountNumber)
+		}
+		if !ok {
+			continue
+		}
+		entry.NtryRef = strconv.Itoa(len(statement.Ntry) + 1)
+		statement.Ntry = append(statement.Ntry, entry)
+
+		amount, _ := account.Details[i].Money(currency)
+		if (amount.Amount < 0) != (entry.CdtDbtInd == Debit) {
+			amount = amount.Neg()
+		}
+		net.Amount += amount.Amount
+	}
+
+	if !intraday {
+		if err := bookedBalances(&statement, net, asOf); err != nil {
+			return Statement{}, fmt.Errorf("%v for account %s", err, account.AccountNumber)
+		}
+	}
+
+	return statement, nil
+}
+
+// bookedBalances adds the opening or closing booked balance that is not reported, derived from the other and
+// the net amount of the entries
+func bookedBalances(statement *Statement, net lib.Money, asOf time.Time) error {
+	opening, closing := -1, -1
+	for i := range statement.Bal {
+		switch statement.Bal[i].Cd {
+		case "OPBD":
+			opening = i
+		case "CLBD":
+			closing = i
+		}
+	}
+
+	balance := func(i int) lib.Money {
+		amount, _ := lib.ParseDecimal(statement.Bal[i].Amt.Value, statement.Bal[i].Amt.Ccy)
+		if statement.Bal[i].CdtDbtInd == Debit {
+			amount = amount.Neg()
+		}
+		return amount
+	}
+
+	switch {
+	case opening < 0 && closing < 0:
+		return errors.New("no opening or closing ledger")
+	case opening < 0:
+		amount := lib.NewMoney(balance(closing).Amount-net.Amount, net.Currency)
+		statement.Bal = append([]Balance{newBalance(lib.TypeCodeOpeningLedger, amount, asOf)}, statement.Bal...)
+	case closing < 0:
+		amount := lib.NewMoney(balance(opening).Amount+net.Amount, net.Currency)
+		statement.Bal = append(statement.Bal[:opening+1], append([]Balance{newBalance(lib.TypeCodeClosingLedger, amount, asOf)}, statement.Bal[opening+1:]...)...)
+	}
+	return nil
+}
+
+// isStatus reports whether the type code reports a balance. Type codes that are not assigned are status
+// type codes below 100.
+func isStatus(lookup func(code string) (lib.TypeCodeDefinition, bool), typeCode string) bool {
+	if definition, ok := lookup(typeCode); ok {
+		return definition.Category == lib.CategoryStatus
+	}
+	return typeCode < "100"
+}
+
+func newBalance(typeCode string, amount lib.Money, asOf time.Time) Balance {
+	balance := Balance{
+		Amt:       newAmount(amount),
+		CdtDbtInd: Credit,
+		Dt:        DateAndDateTime{Dt: isoDate(asOf)},
+	}
+	if amount.Amount < 0 {
+		balance.CdtDbtInd = Debit
+	}
+
+	if code, ok := balanceTypes[typeCode]; ok {
+		balance.Cd = code
+	} else {
+		balance.Prtry = typeCode
+	}
+	return balance
+}
+
+// newEntry returns the entry of a transaction detail, which is not found when the detail is neither
+// a credit nor a debit
+func newEntry(detail *lib.Detail, group *lib.Group, currency string, asOf time.Time) (Entry, bool, error) {
+	direction := group.TypeCodeDirection(detail.TypeCode)
+	code, ok := LookupBankTransactionCode(detail.TypeCode, direction)
+	if !ok {
+		return Entry{}, false, nil
+	}
+
+	amount, err := detail.Money(currency)
+	if err != nil {
+		return Entry{}, false, err
+	}
+
+	entry := Entry{
+		Amt:          newAmount(amount),
+		CdtDbtInd:    indicator(direction, amount),
+		Sts:          EntryStatus{Cd: "BOOK"},
+		BookgDt:      &DateAndDateTime{Dt: isoDate(asOf)},
+		AcctSvcrRef:  detail.BankReferenceNumber,
+		BkTxCd:       code,
+		AddtlNtryInf: strings.TrimSpace(strings.TrimSuffix(detail.Text, "/")),
+	}
+
+	if detail.CustomerReferenceNumber != "" {
+		entry.NtryDtls = []EntryDetails{{
+			TxDtls: []TransactionDetails{{EndToEndId: detail.CustomerReferenceNumber}},
+		}}
+	}
+
+	if strings.EqualFold(string(detail.FundsType.TypeCode), lib.FundsTypeV) {
+		date, err := detail.FundsType.ValueDate(asOf.Location())
+		if err != nil {
+			return Entry{}, false, err
+		}
+		entry.ValDt = &DateAndDateTime{Dt: isoDate(date)}
+		if detail.FundsType.Time != "" {
+			entry.ValDt = &DateAndDateTime{DtTm: isoDateTime(date)}
+		}
+		return entry, true, nil
+	}
+
+	schedule, err := detail.Availability(asOf, currency)
+	if err != nil {
+		return Entry{}, false, err
+	}
+	entry.Avlbty = newAvailability(schedule, asOf, entry.CdtDbtInd)
+
+	return entry, true, nil
+}
+
+// newAvailability returns the number of days after which the amounts become available. Amounts that are all
+// available on the as-of date are not reported.
+func newAvailability(schedule []lib.Availability, asOf time.Time, cdtDbtInd string) []CashAvailability {
+	var availability []CashAvailability
+	deferred := false
+
+	for _, a := range schedule {
+		days := calendarDays(asOf, a.Date)
+		if days > 0 {
+			deferred = true
+		}
+		availability = append(availability, CashAvailability{
+			NbOfDays:  strconv.Itoa(days),
+			Amt:       newAmount(a.Amount),
+			CdtDbtInd: cdtDbtInd,
+		})
+	}
+
+	if !deferred {
+		return nil
+	}
+	return availability
+}
+
+// calendarDays returns the number of calendar days from the date of from to the date of to
+func calendarDays(from, to time.Time) int {
+	day := func(t time.Time) time.Time {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	return int(day(to).Sub(day(from)).Hours() / 24)
+}
+
+// indicator returns whether an amount of the direction is a credit or a debit, negative amounts reversing it
+func indicator(direction lib.TransactionDirection, amount lib.Money) string {
+	credit := direction == lib.DirectionCredit
+	if amount.Amount < 0 {
+		credit = !credit
+	}
+	if credit {
+		return Credit
+	}
+	return Debit
+}
+
+// newAmount returns the amount without its sign
+func newAmount(amount lib.Money) Amount {
+	if amount.Amount < 0 {
+		amount = amount.Neg()
+	}
+	return Amount{Value: amount.Decimal(), Ccy: amount.Currency}
+}
+
+func isoDate(t time.Time) string {
+	return t.Format("2006-01-02")
+}
+
+func isoDateTime(t time.Time) string {
+	return t.Format("2006-01-02T15:04:05-07:00")
+}
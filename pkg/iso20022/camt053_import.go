@@ -0,0 +1,431 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/moov-io/bai2/pkg/lib"
+	"github.com/moov-io/bai2/pkg/util"
+)
+
+/*
+
+CAMT.053 IMPORT
+
+The statements of a camt.053 message of any version are converted into a finalized BAI2 file, in the reverse
+of NewStatement:
+
+	GrpHdr     the MsgId as file identification number, CreDtTm as file creation date and time and the
+	           recipient as receiver, unless lib.ImportReceiver configures another receiver
+	Stmt       statements of accounts serviced by the same institution on the same date make up a group, which
+	           is reported as final previous-day data with the servicer as originator
+	Acct       the IBAN or other identification as account number, and the currency of the account as currency
+	           of its group, unless the first account of the group has another currency
+	Bal        status type codes, with OPBD and PRCD as opening ledger, and proprietary balance types that are
+	           BAI2 status type codes
+	TxsSummry  TtlCdtNtries as 100, TtlDbtNtries as 400, and totals of proprietary bank transaction codes
+	           that are BAI2 summary type codes
+	Ntry       booked entries as transaction details, with AcctSvcrRef as bank reference number, the first
+	           EndToEndId as customer reference number and AddtlNtryInf or AddtlTxInf as text. Availability
+	           sets the funds type, as does a value date later than the as-of date.
+
+The as-of date and time of a statement is the end of its period, the date of its first balance, or its creation
+date and time, in the time zone of the servicer when it has no time zone. The sender of the file is the servicer
+of the first statement unless set by lib.ImportSender, which is required when the statements do not tell the
+servicer of their accounts, and also becomes the originator of the groups of accounts without servicer.
+
+AcctSvcrRef and EndToEndId are written without the commas and slashes they may hold, as those characters would
+end the BAI2 field or record of the reference.
+
+*/
+
+type importer struct {
+	lib.ImportConfig
+}
+
+// balanceTypeCodes maps ISO balance type codes to BAI2 status type codes
+var balanceTypeCodes = func() map[string]string {
+	codes := map[string]string{"PRCD": lib.TypeCodeOpeningLedger}
+	for typeCode, code := range balanceTypes {
+		codes[code] = typeCode
+	}
+	return codes
+}()
+
+// ReadStatement reads a camt.053 message and converts it into a BAI2 file
+func ReadStatement(r io.Reader, opts ...lib.ImportOption) (*lib.Bai2, error) {
+	var doc Document
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, err
+	}
+	return ImportStatement(&doc, opts...)
+}
+
+// ImportStatement converts the statements of a camt.053 message into a BAI2 file
+func ImportStatement(doc *Document, opts ...lib.ImportOption) (*lib.Bai2, error) {
+	if doc.BkToCstmrStmt == nil {
+		return nil, errors.New("document has no camt.053 statement")
+	}
+	message := doc.BkToCstmrStmt
+
+	i := &importer{lib.NewImportConfig(opts...)}
+
+	sender := i.Sender
+	if sender == "" && len(message.Stmt) > 0 {
+		sender = message.Stmt[0].Acct.Servicer()
+	}
+	receiver := i.Receiver
+	if receiver == "" {
+		receiver = message.GrpHdr.MsgRcpt
+	}
+
+	created, err := parseDateTime(message.GrpHdr.CreDtTm, i.TimeZones().Location(sender))
+	if err != nil {
+		return nil, fmt.Errorf("%v in group header", err)
+	}
+
+	builder := lib.NewFileBuilder().
+		Registries(i.RegistryOptions()...).
+		Sender(sender).
+		Receiver(receiver).
+		Created(created).
+		FileID(message.GrpHdr.MsgId)
+
+	type groupKey struct {
+		originator, asOfDate string
+	}
+	var keys []groupKey
+	groups := make(map[groupKey][]*Statement)
+
+	for j := range message.Stmt {
+		statement := &message.Stmt[j]
+
+		originator := statement.Acct.Servicer()
+		if originator == "" {
+			originator = sender
+		}
+		asOf, err := statementAsOf(statement, message.GrpHdr.CreDtTm, i.TimeZones().Location(originator))
+		if err != nil {
+			return nil, fmt.Errorf("%v in statement %s", err, statement.Id)
+		}
+
+		key := groupKey{originator: originator, asOfDate: asOf.Format("2006-01-02")}
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], statement)
+	}
+
+	for _, key := range keys {
+		statements := groups[key]
+
+		asOf, _ := statementAsOf(statements[0], message.GrpHdr.CreDtTm, i.TimeZones().Location(key.originator))
+		builder.Group(key.originator, asOf).AsOfDateModifier(lib.AsOfFinalPreviousDay)
+
+		groupCurrency := statementCurrency(statements[0])
+		if groupCurrency != "" {
+			builder.GroupCurrency(groupCurrency)
+		}
+
+		for _, statement := range statements {
+			if err := i.importAccount(builder, statement, key.originator, groupCurrency, asOf); err != nil {
+				return nil, fmt.Errorf("%v in statement %s", err, statement.Id)
+			}
+		}
+	}
+
+	return builder.Build()
+}
+
+// importAccount adds the account of the statement, with its balances, totals and booked entries
+func (i *importer) importAccount(builder *lib.FileBuilder, statement *Statement, originator, groupCurrency string, asOf time.Time) error {
+	builder.Account(statement.Acct.Identification())
+
+	currency := statementCurrency(statement)
+	if currency != "" && currency != groupCurrency {
+		builder.AccountCurrency(currency)
+	}
+	if currency == "" {
+		currency = groupCurrency
+	}
+	currency = lib.NewMoney(0, currency).Currency
+
+	reported := make(map[string]bool)
+	for _, balance := range statement.Bal {
+		typeCode, ok := balanceTypeCodes[balance.Cd]
+		if !ok && balance.Prtry != "" {
+			typeCode = balance.Prtry
+			if definition, found := i.TypeCodes().Lookup(originator, typeCode); !found || definition.Category != lib.CategoryStatus {
+				continue
+			}
+		}
+		if typeCode == "" || reported[typeCode] {
+			continue
+		}
+		reported[typeCode] = true
+
+		amount, err := parseAmount(balance.Amt, balance.CdtDbtInd, currency)
+		if err != nil {
+			return err
+		}
+		builder.Summary(typeCode, amount.Amount, 0)
+	}
+
+	if summary := statement.TxsSummry; summary != nil {
+		totals := []struct {
+			typeCode string
+			totals   *NumberAndSum
+		}{
+			{lib.TypeCodeTotalCredits, summary.TtlCdtNtries},
+			{lib.TypeCodeTotalDebits, summary.TtlDbtNtries},
+		}
+		for _, t := range totals {
+			if t.totals == nil {
+				continue
+			}
+			if err := importTotals(builder, t.typeCode, t.totals.NbOfNtries, t.totals.Sum, currency); err != nil {
+				return err
+			}
+		}
+
+		for _, t := range summary.TtlNtriesPerBkTxCd {
+			lookup := func(code string) (lib.TypeCodeDefinition, bool) { return i.TypeCodes().Lookup(originator, code) }
+			if !t.BkTxCd.baiTypeCode(i.TypeCodes(), originator, util.AccountIdentifierCode) || isStatus(lookup, t.BkTxCd.Proprietary) {
+				continue
+			}
+			if err := importTotals(builder, t.BkTxCd.Proprietary, t.NbOfNtries, t.Sum, currency); err != nil {
+				return err
+			}
+		}
+	}
+
+	for j := range statement.Ntry {
+		if err := i.importEntry(builder, &statement.Ntry[j], originator, currency, asOf); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func importTotals(builder *lib.FileBuilder, typeCode, count, sum, currency string) error {
+	amount, err := lib.ParseDecimal(sum, currency)
+	if err != nil {
+		return err
+	}
+
+	var items int64
+	if count != "" {
+		if items, err = strconv.ParseInt(count, 10, 64); err != nil {
+			return fmt.Errorf("invalid number of entries %q", count)
+		}
+	}
+
+	builder.Summary(typeCode, amount.Amount, items)
+	return nil
+}
+
+// importEntry adds the transaction detail of a booked entry
+func (i *importer) importEntry(builder *lib.FileBuilder, entry *Entry, originator, currency string, asOf time.Time) error {
+	if status := entry.Sts.Code(); status != "" && status != "BOOK" {
+		return nil
+	}
+
+	amount, err := parseAmount(entry.Amt, Credit, currency)
+	if err != nil {
+		return err
+	}
+
+	direction := lib.DirectionCredit
+	if entry.CdtDbtInd == Debit {
+		direction = lib.DirectionDebit
+	}
+	typeCode := entry.BkTxCd.typeCode(i.TypeCodes(), originator, direction)
+
+	var opts []lib.DetailOption
+
+	reference := entry.AcctSvcrRef
+	var customerReference, text string
+	for _, details := range entry.NtryDtls {
+		for _, tx := range details.TxDtls {
+			if reference == "" {
+				reference = tx.AcctSvcrRef
+			}
+			if customerReference == "" && tx.EndToEndId != "NOTPROVIDED" {
+				customerReference = tx.EndToEndId
+			}
+			if text == "" {
+				text = tx.AddtlTxInf
+			}
+		}
+	}
+	if entry.AddtlNtryInf != "" {
+		text = entry.AddtlNtryInf
+	}
+
+	if reference = util.SanitizeField(reference); reference != "" {
+		opts = append(opts, lib.WithBankReference(reference))
+	}
+	if customerReference = util.SanitizeField(customerReference); customerReference != "" {
+		opts = append(opts, lib.WithCustomerReference(customerReference))
+	}
+	if text = strings.TrimSpace(strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ").Replace(text)); text != "" {
+		opts = append(opts, lib.WithText(text))
+	}
+
+	fundsType, err := entryFundsType(entry, amount, currency, asOf)
+	if err != nil {
+		return err
+	}
+	if fundsType.TypeCode != "" {
+		opts = append(opts, lib.WithFundsType(fundsType))
+	}
+
+	if direction == lib.DirectionDebit {
+		builder.Debit(typeCode, amount.Amount, opts...)
+	} else {
+		builder.Credit(typeCode, amount.Amount, opts...)
+	}
+	return nil
+}
+
+// entryFundsType returns the funds type of the availability of the entry, or of its value date
+func entryFundsType(entry *Entry, amount lib.Money, currency string, asOf time.Time) (lib.FundsType, error) {
+	if len(entry.Avlbty) == 0 {
+		if entry.ValDt == nil {
+			return lib.FundsType{}, nil
+		}
+
+		value := entry.ValDt.DtTm
+		if value == "" {
+			value = entry.ValDt.Dt
+		}
+		date, err := parseDateTime(value, asOf.Location())
+		if err != nil {
+			return lib.FundsType{}, err
+		}
+		if calendarDays(asOf, date) <= 0 {
+			return lib.FundsType{}, nil
+		}
+
+		if entry.ValDt.DtTm == "" {
+			return lib.FundsType{TypeCode: lib.FundsTypeV, Date: date.Format("060102")}, nil
+		}
+		var fundsType lib.FundsType
+		fundsType.SetValueDate(date.In(asOf.Location()))
+		return fundsType, nil
+	}
+
+	var distributions []lib.Distribution
+	sameDay := true
+	for _, availability := range entry.Avlbty {
+		days := int64(0)
+		switch {
+		case availability.NbOfDays != "":
+			n, err := strconv.ParseInt(availability.NbOfDays, 10, 64)
+			if err != nil || n < 0 {
+				return lib.FundsType{}, fmt.Errorf("invalid number of days %q", availability.NbOfDays)
+			}
+			days = n
+		case availability.ActlDt != "":
+			date, err := parseDateTime(availability.ActlDt, asOf.Location())
+			if err != nil {
+				return lib.FundsType{}, err
+			}
+			if d := calendarDays(asOf, date); d > 0 {
+				days = int64(d)
+			}
+		}
+
+		available, err := parseAmount(availability.Amt, Credit, currency)
+		if err != nil {
+			return lib.FundsType{}, err
+		}
+		if days > 2 {
+			sameDay = false
+		}
+		distributions = append(distributions, lib.Distribution{Day: days, Amount: available.Amount})
+	}
+
+	if len(distributions) == 1 && distributions[0].Amount == amount.Amount && distributions[0].Day <= 2 {
+		return lib.FundsType{TypeCode: lib.FundsTypeCode(strconv.FormatInt(distributions[0].Day, 10))}, nil
+	}
+	if !sameDay {
+		return lib.FundsType{TypeCode: lib.FundsTypeD, DistributionNumber: int64(len(distributions)), Distributions: distributions}, nil
+	}
+
+	fundsType := lib.FundsType{TypeCode: lib.FundsTypeS}
+	for _, distribution := range distributions {
+		switch distribution.Day {
+		case 0:
+			fundsType.ImmediateAmount += distribution.Amount
+		case 1:
+			fundsType.OneDayAmount += distribution.Amount
+		default:
+			fundsType.TwoDayAmount += distribution.Amount
+		}
+	}
+	return fundsType, nil
+}
+
+// statementAsOf returns the as-of date and time of the statement
+func statementAsOf(statement *Statement, created string, location *time.Location) (time.Time, error) {
+	value := statement.CreDtTm
+	if value == "" {
+		value = created
+	}
+	if len(statement.Bal) > 0 {
+		value = statement.Bal[0].Dt.DtTm
+		if value == "" {
+			value = statement.Bal[0].Dt.Dt
+		}
+	}
+	if statement.FrToDt != nil && statement.FrToDt.ToDtTm != "" {
+		value = statement.FrToDt.ToDtTm
+	}
+	return parseDateTime(value, location)
+}
+
+// statementCurrency returns the currency of the account of the statement, or of its first balance
+func statementCurrency(statement *Statement) string {
+	currency := statement.Acct.Ccy
+	if currency == "" && len(statement.Bal) > 0 {
+		currency = statement.Bal[0].Amt.Ccy
+	}
+	return strings.ToUpper(currency)
+}
+
+// parseAmount returns the amount in the currency, with the sign of the credit or debit indicator
+func parseAmount(amount Amount, cdtDbtInd, currency string) (lib.Money, error) {
+	if amount.Ccy != "" && !strings.EqualFold(amount.Ccy, currency) {
+		return lib.Money{}, fmt.Errorf("%s amount in %s account", strings.ToUpper(amount.Ccy), currency)
+	}
+
+	money, err := lib.ParseDecimal(strings.TrimSpace(amount.Value), currency)
+	if err != nil {
+		return lib.Money{}, err
+	}
+	if cdtDbtInd == Debit {
+		money = money.Neg()
+	}
+	return money, nil
+}
+
+// parseDateTime parses an ISO date or date and time, which is in the location when it has no time zone
+func parseDateTime(value string, location *time.Location) (time.Time, error) {
+	for _, layout := range []string{"2006-01-02T15:04:05Z07:00", "2006-01-02T15:04:05", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, strings.TrimSpace(value), location); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date and time %q", value)
+}
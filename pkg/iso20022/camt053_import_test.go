@@ -0,0 +1,192 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/bai2/pkg/lib"
+)
+
+const camt053Version2 = `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.02">
+  <BkToCstmrStmt>
+    <GrpHdr>
+      <MsgId>STMT-20230105</MsgId>
+      <CreDtTm>2023-01-05T06:15:00</CreDtTm>
+      <MsgRcpt><Nm>ACME</Nm><Id><OrgId><Othr><Id>ACME01</Id></Othr></OrgId></Id></MsgRcpt>
+    </GrpHdr>
+    <Stmt>
+      <Id>1</Id>
+      <CreDtTm>2023-01-05T06:15:00</CreDtTm>
+      <FrToDt><FrDtTm>2023-01-04T00:00:00</FrDtTm><ToDtTm>2023-01-04T23:59:59</ToDtTm></FrToDt>
+      <Acct>
+        <Id><IBAN>DE89370400440532013000</IBAN></Id>
+        <Ccy>EUR</Ccy>
+        <Svcr><FinInstnId><BIC>COBADEFFXXX</BIC></FinInstnId></Svcr>
+      </Acct>
+      <Bal>
+        <Tp><CdOrPrtry><Cd>PRCD</Cd></CdOrPrtry></Tp>
+        <Amt Ccy="EUR">1500.5</Amt>
+        <CdtDbtInd>CRDT</CdtDbtInd>
+        <Dt><Dt>2023-01-03</Dt></Dt>
+      </Bal>
+      <Bal>
+        <Tp><CdOrPrtry><Cd>CLBD</Cd></CdOrPrtry></Tp>
+        <Amt Ccy="EUR">250.00</Amt>
+        <CdtDbtInd>DBIT</CdtDbtInd>
+        <Dt><Dt>2023-01-04</Dt></Dt>
+      </Bal>
+      <Bal>
+        <Tp><CdOrPrtry><Cd>FWAV</Cd></CdOrPrtry></Tp>
+        <Amt Ccy="EUR">100.00</Amt>
+        <CdtDbtInd>CRDT</CdtDbtInd>
+        <Dt><Dt>2023-01-05</Dt></Dt>
+      </Bal>
+      <TxsSummry>
+        <TtlCdtNtries><NbOfNtries>1</NbOfNtries><Sum>1000.00</Sum></TtlCdtNtries>
+        <TtlDbtNtries><NbOfNtries>1</NbOfNtries><Sum>2750.50</Sum></TtlDbtNtries>
+      </TxsSummry>
+      <Ntry>
+        <Amt Ccy="EUR">1000.00</Amt>
+        <CdtDbtInd>CRDT</CdtDbtInd>
+        <Sts>BOOK</Sts>
+        <BookgDt><Dt>2023-01-04</Dt></BookgDt>
+        <ValDt><Dt>2023-01-06</Dt></ValDt>
+        <AcctSvcrRef>REF,123/4</AcctSvcrRef>
+        <BkTxCd><Domn><Cd>PMNT</Cd><Fmly><Cd>RCDT</Cd><SubFmlyCd>ESCT</SubFmlyCd></Fmly></Domn></BkTxCd>
+        <NtryDtls>
+          <TxDtls>
+            <Refs><EndToEndId>NOTPROVIDED</EndToEndId></Refs>
+            <AddtlTxInf>SEPA CREDIT
+INVOICE 42</AddtlTxInf>
+          </TxDtls>
+        </NtryDtls>
+      </Ntry>
+      <Ntry>
+        <Amt Ccy="EUR">2750.50</Amt>
+        <CdtDbtInd>DBIT</CdtDbtInd>
+        <Sts>BOOK</Sts>
+        <BookgDt><Dt>2023-01-04</Dt></BookgDt>
+        <Avlbty><Dt><NbOfDays>0</NbOfDays></Dt><Amt Ccy="EUR">750.50</Amt><CdtDbtInd>DBIT</CdtDbtInd></Avlbty>
+        <Avlbty><Dt><ActlDt>2023-01-09</ActlDt></Dt><Amt Ccy="EUR">2000.00</Amt><CdtDbtInd>DBIT</CdtDbtInd></Avlbty>
+        <BkTxCd><Domn><Cd>PMNT</Cd><Fmly><Cd>ICHQ</Cd><SubFmlyCd>CCHQ</SubFmlyCd></Fmly></Domn></BkTxCd>
+        <NtryDtls><TxDtls><Refs><EndToEndId>CHQ 1001</EndToEndId></Refs></TxDtls></NtryDtls>
+      </Ntry>
+      <Ntry>
+        <Amt Ccy="EUR">5.00</Amt>
+        <CdtDbtInd>DBIT</CdtDbtInd>
+        <Sts>PDNG</Sts>
+        <BkTxCd><Domn><Cd>ACMT</Cd><Fmly><Cd>MDOP</Cd><SubFmlyCd>CHRG</SubFmlyCd></Fmly></Domn></BkTxCd>
+      </Ntry>
+    </Stmt>
+  </BkToCstmrStmt>
+</Document>`
+
+func TestReadStatement(t *testing.T) {
+	file, err := ReadStatement(strings.NewReader(camt053Version2), lib.ImportSender("COBADEFF"))
+	require.NoError(t, err)
+	require.NoError(t, file.ValidateIntegrity())
+
+	expected := `01,COBADEFF,ACME01,230105,0615,STMT-20230105,,,2/
+02,ACME01,COBADEFFXXX,1,230104,2359,EUR,2/
+03,DE89370400440532013000,,010,150050,,,015,-25000,,,100,100000,1,,400,275050,1,/
+16,399,100000,V,230106,,REF1234,,SEPA CREDIT INVOICE 42/
+16,475,275050,D,2,0,75050,5,200000,,CHQ 1001,/
+49,875150,4/
+98,875150,1,6/
+99,875150,1,8/`
+	require.Equal(t, expected, file.String())
+
+	// the servicer of the accounts is the default sender
+	file, err = ReadStatement(strings.NewReader(camt053Version2))
+	require.NoError(t, err)
+	require.Equal(t, "COBADEFFXXX", file.Sender)
+	require.Equal(t, "COBADEFFXXX", file.Groups[0].Originator)
+
+	withoutServicer := strings.Replace(camt053Version2, "<Svcr><FinInstnId><BIC>COBADEFFXXX</BIC></FinInstnId></Svcr>", "", 1)
+	_, err = ReadStatement(strings.NewReader(withoutServicer))
+	require.EqualError(t, err, "FileBuilder: invalid Sender")
+
+	_, err = ReadStatement(strings.NewReader(strings.Replace(camt053Version2, "1500.5", "1500.555", 1)), lib.ImportSender("COBADEFF"))
+	require.EqualError(t, err, `invalid amount "1500.555" for currency EUR in statement 1`)
+
+	_, err = ReadStatement(strings.NewReader(`<Document><BkToCstmrAcctRpt/></Document>`))
+	require.EqualError(t, err, "document has no camt.053 statement")
+}
+
+func TestReadStatement_TimeZones(t *testing.T) {
+	berlin := time.FixedZone("CET", 60*60)
+	zones := lib.NewTimeZoneRegistry()
+	zones.Register("COBADEFF", berlin)
+	zones.Register("COBADEFFXXX", berlin)
+
+	file, err := ReadStatement(strings.NewReader(camt053Version2), lib.ImportSender("COBADEFF"), lib.ImportRegistries(lib.UseTimeZones(zones)))
+	require.NoError(t, err)
+	require.Equal(t, "0615", file.FileCreatedTime)
+
+	created, err := file.FileCreated()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2023, time.January, 5, 5, 15, 0, 0, time.UTC), created.UTC())
+
+	asOf, err := file.Groups[0].AsOf()
+	require.NoError(t, err)
+	require.Equal(t, berlin, asOf.Location())
+}
+
+func TestStatementRoundTrip(t *testing.T) {
+	scan := lib.NewBai2Scanner(strings.NewReader(statementSample))
+	file := lib.NewBai2()
+	require.NoError(t, file.Read(&scan))
+
+	doc, err := NewStatement(file)
+	require.NoError(t, err)
+	data, err := doc.XML()
+	require.NoError(t, err)
+
+	read, err := ReadStatement(bytes.NewReader(data), lib.ImportSender("0004"), lib.ImportReceiver("12345"))
+	require.NoError(t, err)
+	require.NoError(t, read.ValidateIntegrity())
+
+	// non-monetary information is not reported by statements, nor the availability of immediately
+	// available amounts, and the ledger balances that are not reported are derived
+	account := &file.Groups[0].Accounts[0]
+	account.Details = account.Details[:len(account.Details)-1]
+	account.Details[2].FundsType = lib.FundsType{}
+	account.Summaries = append(account.Summaries[:1], append([]lib.AccountSummary{{TypeCode: lib.TypeCodeClosingLedger, Amount: "190000"}}, account.Summaries[1:]...)...)
+	account = &file.Groups[0].Accounts[1]
+	account.Summaries = append([]lib.AccountSummary{{TypeCode: lib.TypeCodeOpeningLedger, Amount: "-500"}}, account.Summaries...)
+	require.NoError(t, file.Finalize())
+
+	require.Equal(t, file.String(), read.String())
+}
+
+func TestBankTransactionCodeTypeCode(t *testing.T) {
+	testCases := []struct {
+		code      BankTransactionCode
+		direction lib.TransactionDirection
+		expected  string
+	}{
+		{BankTransactionCode{Proprietary: "555", Issuer: "bai"}, lib.DirectionDebit, "555"},
+		// proprietary codes of other issuers or of the other direction are not type codes
+		{BankTransactionCode{Proprietary: "555", Issuer: "BANK"}, lib.DirectionDebit, lib.TypeCodeMiscellaneousDebit},
+		{BankTransactionCode{Domain: "PMNT", Family: "ICHQ", SubFamily: "CCHQ", Proprietary: "555", Issuer: BAI}, lib.DirectionCredit, lib.TypeCodeMiscellaneousCredit},
+		// summary type codes are not detail type codes
+		{BankTransactionCode{Domain: "PMNT", Family: "RCDT", SubFamily: "ACDT"}, lib.DirectionCredit, lib.TypeCodeACHCreditReceived},
+		{BankTransactionCode{Domain: "PMNT", Family: "MCOP", SubFamily: "OTHR"}, lib.DirectionCredit, lib.TypeCodeMiscellaneousCredit},
+		{BankTransactionCode{Domain: "CAMT", Family: "ACCB", SubFamily: "ZABA"}, lib.DirectionCredit, "275"},
+		{BankTransactionCode{Domain: "CAMT", Family: "ACCB", SubFamily: "ZABA"}, lib.DirectionDebit, "575"},
+		{BankTransactionCode{Domain: "PMNT", Family: "RCDT", SubFamily: "ESCT"}, lib.DirectionCredit, lib.TypeCodeMiscellaneousCredit},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected, tc.code.TypeCode("", tc.direction), "%+v", tc.code)
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/bai2/pkg/lib"
+)
+
+// statementSample is an end-of-day group with a CAD account reporting an opening ledger, summaries and
+// details of every kind of availability, and a USD account reporting a closing ledger
+const statementSample = `01,0004,12345,060321,0829,001,,,2/
+02,12345,0004,1,060317,0000,CAD,2/
+03,10200123456,,010,100000,,,072,2500,,,100,92500,2,,140,2500,1,/
+16,115,90000,S,60000,30000,0,1234567,,LOCK BOX NO.68751/
+16,142,2500,V,060320,,,INV-1,/
+16,555,2500,0,,,/
+16,890,,,,,NOTICE/
+49,292500,6/
+03,10200123457,USD,015,-500,,/
+49,-500,2/
+98,292000,2,10/
+99,292000,1,12/`
+
+func TestNewStatement(t *testing.T) {
+	scan := lib.NewBai2Scanner(strings.NewReader(statementSample))
+	file := lib.NewBai2()
+	require.NoError(t, file.Read(&scan))
+
+	doc, err := NewStatement(file)
+	require.NoError(t, err)
+
+	data, err := doc.XML()
+	require.NoError(t, err)
+	require.Contains(t, string(data), `<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.08">`)
+
+	var read Document
+	require.NoError(t, xml.Unmarshal(data, &read))
+	require.Equal(t, Camt053Namespace, read.Xmlns)
+
+	message := read.BkToCstmrStmt
+	require.NotNil(t, message)
+	require.Equal(t, GroupHeader{MsgId: "001", CreDtTm: "2006-03-21T08:29:00+00:00", MsgRcpt: "12345", AddtlInf: "0004"}, message.GrpHdr)
+	require.Len(t, message.Stmt, 2)
+	for _, statement := range message.Stmt {
+		require.NotEmpty(t, statement.Bal, statement.Id)
+	}
+
+	statement := message.Stmt[0]
+	require.Equal(t, "001-1-1", statement.Id)
+	require.Equal(t, CashAccount{Othr: "10200123456", Ccy: "CAD", SvcrMmbId: "0004"}, statement.Acct)
+	// the closing booked balance is derived from the opening balance and the entries
+	require.Equal(t, []Balance{
+		{Cd: "OPBD", Amt: Amount{Value: "1000.00", Ccy: "CAD"}, CdtDbtInd: Credit, Dt: DateAndDateTime{Dt: "2006-03-17"}},
+		{Cd: "CLBD", Amt: Amount{Value: "1900.00", Ccy: "CAD"}, CdtDbtInd: Credit, Dt: DateAndDateTime{Dt: "2006-03-17"}},
+		{Prtry: "072", Amt: Amount{Value: "25.00", Ccy: "CAD"}, CdtDbtInd: Credit, Dt: DateAndDateTime{Dt: "2006-03-17"}},
+	}, statement.Bal)
+
+	require.NotNil(t, statement.TxsSummry)
+	require.Equal(t, &NumberAndSum{NbOfNtries: "2", Sum: "925.00"}, statement.TxsSummry.TtlCdtNtries)
+	require.Nil(t, statement.TxsSummry.TtlDbtNtries)
+	require.Equal(t, []TotalsPerBankTransactionCode{{
+		NbOfNtries: "1",
+		Sum:        "25.00",
+		CdtDbtInd:  Credit,
+		BkTxCd:     BankTransactionCode{Domain: "PMNT", Family: "RCDT", SubFamily: "ACDT", Proprietary: "140", Issuer: BAI},
+	}}, statement.TxsSummry.TtlNtriesPerBkTxCd)
+
+	require.Len(t, statement.Ntry, 3)
+
+	entry := statement.Ntry[0]
+	require.Equal(t, "1", entry.NtryRef)
+	require.Equal(t, Amount{Value: "900.00", Ccy: "CAD"}, entry.Amt)
+	require.Equal(t, Credit, entry.CdtDbtInd)
+	require.Equal(t, "BOOK", entry.Sts.Code())
+	require.Equal(t, &DateAndDateTime{Dt: "2006-03-17"}, entry.BookgDt)
+	require.Equal(t, "1234567", entry.AcctSvcrRef)
+	require.Equal(t, "LOCK BOX NO.68751", entry.AddtlNtryInf)
+	require.Equal(t, BankTransactionCode{Domain: "PMNT", Family: "LBOX", SubFamily: "LBDP", Proprietary: "115", Issuer: BAI}, entry.BkTxCd)
+	require.Equal(t, []CashAvailability{
+		{NbOfDays: "0", Amt: Amount{Value: "600.00", Ccy: "CAD"}, CdtDbtInd: Credit},
+		{NbOfDays: "1", Amt: Amount{Value: "300.00", Ccy: "CAD"}, CdtDbtInd: Credit},
+	}, entry.Avlbty)
+
+	entry = statement.Ntry[1]
+	require.Equal(t, &DateAndDateTime{Dt: "2006-03-20"}, entry.ValDt)
+	require.Empty(t, entry.Avlbty)
+	require.Equal(t, []EntryDetails{{TxDtls: []TransactionDetails{{EndToEndId: "INV-1"}}}}, entry.NtryDtls)
+
+	// type codes without a mapping are reported as miscellaneous operations, and immediately available
+	// amounts without availability
+	entry = statement.Ntry[2]
+	require.Equal(t, Debit, entry.CdtDbtInd)
+	require.Equal(t, BankTransactionCode{Domain: "PMNT", Family: "MDOP", SubFamily: "OTHR", Proprietary: "555", Issuer: BAI}, entry.BkTxCd)
+	require.Empty(t, entry.Avlbty)
+
+	statement = message.Stmt[1]
+	require.Equal(t, "001-1-2", statement.Id)
+	require.Equal(t, "USD", statement.Acct.Ccy)
+	require.Equal(t, []Balance{
+		{Cd: "OPBD", Amt: Amount{Value: "5.00", Ccy: "USD"}, CdtDbtInd: Debit, Dt: DateAndDateTime{Dt: "2006-03-17"}},
+		{Cd: "CLBD", Amt: Amount{Value: "5.00", Ccy: "USD"}, CdtDbtInd: Debit, Dt: DateAndDateTime{Dt: "2006-03-17"}},
+	}, statement.Bal)
+	require.Nil(t, statement.TxsSummry)
+	require.Empty(t, statement.Ntry)
+}
+
+func TestNewStatement_EndOfDay(t *testing.T) {
+	// the end of day as-of time 9999 still dates the statement on its as-of date
+	scan := lib.NewBai2Scanner(strings.NewReader(strings.Replace(statementSample, "060317,0000", "060317,9999", 1)))
+	file := lib.NewBai2()
+	require.NoError(t, file.Read(&scan))
+
+	doc, err := NewStatement(file)
+	require.NoError(t, err)
+
+	statement := doc.BkToCstmrStmt.Stmt[0]
+	for _, balance := range statement.Bal {
+		require.Equal(t, DateAndDateTime{Dt: "2006-03-17"}, balance.Dt, balance.Cd)
+	}
+	require.Equal(t, &DateAndDateTime{Dt: "2006-03-17"}, statement.Ntry[0].BookgDt)
+	require.Equal(t, "0", statement.Ntry[0].Avlbty[0].NbOfDays)
+}
+
+func TestNewStatementErrors(t *testing.T) {
+	scan := lib.NewBai2Scanner(strings.NewReader(statementSample))
+	file := lib.NewBai2()
+	require.NoError(t, file.Read(&scan))
+	file.FileCreatedDate = "061321"
+	_, err := NewStatement(file)
+	require.EqualError(t, err, `FileHeader: invalid date "061321"`)
+
+	scan = lib.NewBai2Scanner(strings.NewReader(statementSample))
+	file = lib.NewBai2()
+	require.NoError(t, file.Read(&scan))
+	file.Groups[0].Accounts[0].Details[0].Amount = "1.5"
+	_, err = NewStatement(file)
+	require.EqualError(t, err, `invalid amount "1.5" for account 10200123456 in group 1`)
+
+	// statements have booked balances
+	scan = lib.NewBai2Scanner(strings.NewReader(statementSample))
+	file = lib.NewBai2()
+	require.NoError(t, file.Read(&scan))
+	file.Groups[0].Accounts[1].Summaries = nil
+	_, err = NewStatement(file)
+	require.EqualError(t, err, "no opening or closing ledger for account 10200123457 in group 1")
+}
+
+func TestNewStatement_Samples(t *testing.T) {
+	fd, err := os.Open(filepath.Join("..", "..", "test", "testdata", "sample2.txt"))
+	require.NoError(t, err)
+	defer fd.Close()
+
+	scan := lib.NewBai2Scanner(fd)
+	file := lib.NewBai2()
+	require.NoError(t, file.Read(&scan))
+
+	doc, err := NewStatement(file)
+	require.NoError(t, err)
+
+	statements := doc.BkToCstmrStmt.Stmt
+	require.Len(t, statements, 5)
+	for _, statement := range statements {
+		var codes []string
+		for _, balance := range statement.Bal {
+			codes = append(codes, balance.Cd)
+		}
+		require.Subset(t, codes, []string{"OPBD", "CLBD"}, statement.Id)
+	}
+
+	statement := statements[0]
+	require.Equal(t, "1-1-1", statement.Id)
+	require.Equal(t, CashAccount{Othr: "0123456789", Ccy: "USD", SvcrMmbId: "122099999"}, statement.Acct)
+	require.Equal(t, Balance{Cd: "CLBD", Amt: Amount{Value: "48000.00", Ccy: "USD"}, CdtDbtInd: Credit, Dt: DateAndDateTime{Dt: "2004-06-20"}}, statement.Bal[1])
+	require.Len(t, statement.Ntry, 1)
+
+	statement = statements[2]
+	require.Equal(t, "1-2-1", statement.Id)
+	require.Len(t, statement.Ntry, 2)
+	require.Equal(t, &DateAndDateTime{Dt: "2004-06-22"}, statement.Ntry[0].ValDt)
+	require.Equal(t, "PROCEEDS OF LETTER OF CREDIT FROM THE ARAMCO OIL CO", statement.Ntry[0].AddtlNtryInf)
+
+	// the accounts of sample1 report available balances only
+	fd, err = os.Open(filepath.Join("..", "..", "test", "testdata", "sample1.txt"))
+	require.NoError(t, err)
+	defer fd.Close()
+
+	scan = lib.NewBai2Scanner(fd)
+	file = lib.NewBai2()
+	require.NoError(t, file.Read(&scan))
+
+	_, err = NewStatement(file)
+	require.EqualError(t, err, "no opening or closing ledger for account 10200123456 in group 1")
+}
+
+func TestLookupBankTransactionCode(t *testing.T) {
+	code, ok := LookupBankTransactionCode(lib.TypeCodeCheckPaid, lib.DirectionDebit)
+	require.True(t, ok)
+	require.Equal(t, BankTransactionCode{Domain: "PMNT", Family: "ICHQ", SubFamily: "CCHQ", Proprietary: "475", Issuer: BAI}, code)
+
+	code, ok = LookupBankTransactionCode("950", lib.DirectionCredit)
+	require.True(t, ok)
+	require.Equal(t, BankTransactionCode{Domain: "PMNT", Family: "MCOP", SubFamily: "OTHR", Proprietary: "950", Issuer: BAI}, code)
+
+	_, ok = LookupBankTransactionCode(lib.TypeCodeNonMonetaryInformation, lib.DirectionNone)
+	require.False(t, ok)
+}
@@ -0,0 +1,222 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+)
+
+/*
+
+ISO 20022 CASH MANAGEMENT MESSAGES
+
+The documents of this package hold the elements of the bank-to-customer cash management messages that carry
+the information of BAI2 files. Elements are named after their XML tags, and nested elements that only hold
+a single value are flattened into the field of their parent.
+
+*/
+
+// Namespace of the messages written by this package
+const (
+	Camt052Namespace = "urn:iso:std:iso:20022:tech:xsd:camt.052.001.08"
+	Camt053Namespace = "urn:iso:std:iso:20022:tech:xsd:camt.053.001.08"
+)
+
+// Credit and debit indicators
+const (
+	Credit = "CRDT"
+	Debit  = "DBIT"
+)
+
+// Document is the root element of a cash management message
+type Document struct {
+	XMLName xml.Name `xml:"Document"`
+	Xmlns   string   `xml:"xmlns,attr,omitempty"`
+
+	BkToCstmrAcctRpt *BankToCustomerAccountReport `xml:"BkToCstmrAcctRpt,omitempty"`
+	BkToCstmrStmt    *BankToCustomerStatement     `xml:"BkToCstmrStmt,omitempty"`
+}
+
+// XML returns the document with an XML declaration
+func (d *Document) XML() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+
+	encoder := xml.NewEncoder(&buf)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(d); err != nil {
+		return nil, err
+	}
+
+	buf.WriteString("\n")
+	return buf.Bytes(), nil
+}
+
+// BankToCustomerStatement is a camt.053 message, which reports the booked entries and balances of accounts
+type BankToCustomerStatement struct {
+	GrpHdr GroupHeader `xml:"GrpHdr"`
+	Stmt   []Statement `xml:"Stmt"`
+}
+
+// BankToCustomerAccountReport is a camt.052 message, which reports the entries and balances of accounts
+// during the day
+type BankToCustomerAccountReport struct {
+	GrpHdr GroupHeader `xml:"GrpHdr"`
+	Rpt    []Statement `xml:"Rpt"`
+}
+
+// GroupHeader identifies the message
+type GroupHeader struct {
+	MsgId   string `xml:"MsgId"`
+	CreDtTm string `xml:"CreDtTm"`
+	// identification of the recipient of the message
+	MsgRcpt string `xml:"MsgRcpt>Id>OrgId>Othr>Id,omitempty"`
+	// identification of the sender of the message, which the messages do not otherwise carry
+	AddtlInf string `xml:"AddtlInf,omitempty"`
+}
+
+// Statement reports the entries and balances of an account, in a statement or in a report
+type Statement struct {
+	Id           string               `xml:"Id"`
+	ElctrncSeqNb string               `xml:"ElctrncSeqNb,omitempty"`
+	CreDtTm      string               `xml:"CreDtTm"`
+	FrToDt       *DateTimePeriod      `xml:"FrToDt,omitempty"`
+	Acct         CashAccount          `xml:"Acct"`
+	Bal          []Balance            `xml:"Bal"`
+	TxsSummry    *TransactionsSummary `xml:"TxsSummry,omitempty"`
+	Ntry         []Entry              `xml:"Ntry"`
+}
+
+// DateTimePeriod is the period covered by a statement or report
+type DateTimePeriod struct {
+	FrDtTm string `xml:"FrDtTm"`
+	ToDtTm string `xml:"ToDtTm"`
+}
+
+// CashAccount identifies an account by its IBAN or by another identification, and the institution servicing it
+type CashAccount struct {
+	IBAN string `xml:"Id>IBAN,omitempty"`
+	Othr string `xml:"Id>Othr>Id,omitempty"`
+	Ccy  string `xml:"Ccy,omitempty"`
+	// BIC of the servicing institution, held by the BIC element in earlier versions of the messages
+	SvcrBICFI string `xml:"Svcr>FinInstnId>BICFI,omitempty"`
+	SvcrBIC   string `xml:"Svcr>FinInstnId>BIC,omitempty"`
+	// clearing system member identification of the servicing institution, such as an ABA routing number
+	SvcrMmbId string `xml:"Svcr>FinInstnId>ClrSysMmbId>MmbId,omitempty"`
+}
+
+// Identification returns the IBAN of the account, or its other identification
+func (a CashAccount) Identification() string {
+	if a.IBAN != "" {
+		return a.IBAN
+	}
+	return a.Othr
+}
+
+// Servicer returns the clearing system member identification of the servicing institution, or its BIC
+func (a CashAccount) Servicer() string {
+	switch {
+	case a.SvcrMmbId != "":
+		return a.SvcrMmbId
+	case a.SvcrBICFI != "":
+		return a.SvcrBICFI
+	}
+	return a.SvcrBIC
+}
+
+// Amount is an amount in major units of its currency, without sign
+type Amount struct {
+	Value string `xml:",chardata"`
+	Ccy   string `xml:"Ccy,attr"`
+}
+
+// Balance is a balance of the account, identified by an ISO balance type code or a proprietary type
+type Balance struct {
+	Cd        string             `xml:"Tp>CdOrPrtry>Cd,omitempty"`
+	Prtry     string             `xml:"Tp>CdOrPrtry>Prtry,omitempty"`
+	Amt       Amount             `xml:"Amt"`
+	CdtDbtInd string             `xml:"CdtDbtInd"`
+	Dt        DateAndDateTime    `xml:"Dt"`
+	Avlbty    []CashAvailability `xml:"Avlbty,omitempty"`
+}
+
+// DateAndDateTime is either an ISO date or an ISO date and time
+type DateAndDateTime struct {
+	Dt   string `xml:"Dt,omitempty"`
+	DtTm string `xml:"DtTm,omitempty"`
+}
+
+// CashAvailability is an amount that becomes available after a number of days, or at a date
+type CashAvailability struct {
+	NbOfDays  string `xml:"Dt>NbOfDays,omitempty"`
+	ActlDt    string `xml:"Dt>ActlDt,omitempty"`
+	Amt       Amount `xml:"Amt"`
+	CdtDbtInd string `xml:"CdtDbtInd"`
+}
+
+// TransactionsSummary holds the totals of the entries of the account
+type TransactionsSummary struct {
+	TtlCdtNtries       *NumberAndSum                  `xml:"TtlCdtNtries,omitempty"`
+	TtlDbtNtries       *NumberAndSum                  `xml:"TtlDbtNtries,omitempty"`
+	TtlNtriesPerBkTxCd []TotalsPerBankTransactionCode `xml:"TtlNtriesPerBkTxCd,omitempty"`
+}
+
+// NumberAndSum is the number of entries and the sum of their amounts
+type NumberAndSum struct {
+	NbOfNtries string `xml:"NbOfNtries,omitempty"`
+	Sum        string `xml:"Sum,omitempty"`
+}
+
+// TotalsPerBankTransactionCode is the number and sum of the entries of a bank transaction code
+type TotalsPerBankTransactionCode struct {
+	NbOfNtries string              `xml:"NbOfNtries,omitempty"`
+	Sum        string              `xml:"Sum,omitempty"`
+	CdtDbtInd  string              `xml:"CdtDbtInd,omitempty"`
+	BkTxCd     BankTransactionCode `xml:"BkTxCd"`
+}
+
+// Entry is a transaction booked to the account
+type Entry struct {
+	NtryRef      string              `xml:"NtryRef,omitempty"`
+	Amt          Amount              `xml:"Amt"`
+	CdtDbtInd    string              `xml:"CdtDbtInd"`
+	Sts          EntryStatus         `xml:"Sts"`
+	BookgDt      *DateAndDateTime    `xml:"BookgDt,omitempty"`
+	ValDt        *DateAndDateTime    `xml:"ValDt,omitempty"`
+	AcctSvcrRef  string              `xml:"AcctSvcrRef,omitempty"`
+	Avlbty       []CashAvailability  `xml:"Avlbty,omitempty"`
+	BkTxCd       BankTransactionCode `xml:"BkTxCd"`
+	NtryDtls     []EntryDetails      `xml:"NtryDtls,omitempty"`
+	AddtlNtryInf string              `xml:"AddtlNtryInf,omitempty"`
+}
+
+// EntryStatus is the status of an entry, such as BOOK. Earlier versions of the messages hold the code
+// without the Cd element, which is read as well.
+type EntryStatus struct {
+	Cd    string `xml:"Cd,omitempty"`
+	Value string `xml:",chardata"`
+}
+
+// Code returns the status code
+func (s EntryStatus) Code() string {
+	if s.Cd != "" {
+		return s.Cd
+	}
+	return strings.TrimSpace(s.Value)
+}
+
+// EntryDetails holds the transactions of an entry
+type EntryDetails struct {
+	TxDtls []TransactionDetails `xml:"TxDtls"`
+}
+
+// TransactionDetails holds the references of a transaction
+type TransactionDetails struct {
+	AcctSvcrRef string `xml:"Refs>AcctSvcrRef,omitempty"`
+	EndToEndId  string `xml:"Refs>EndToEndId,omitempty"`
+	AddtlTxInf  string `xml:"AddtlTxInf,omitempty"`
+}
@@ -8,8 +8,8 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
-	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/moov-io/bai2/pkg/util"
 )
@@ -50,6 +50,15 @@ type Account struct {
 
 	header  accountIdentifier
 	trailer accountTrailer
+
+	// number of physical records the account was read from
+	parsedRecords int64
+	// originator of the group, which defines the meaning of customized type codes
+	originator string
+	// currency code of the group, which is the default currency of the account
+	groupCurrency string
+	// registries interpreting the account
+	registries registries
 }
 
 func (r *Account) copyRecords() {
@@ -58,6 +67,8 @@ func (r *Account) copyRecords() {
 		AccountNumber: r.AccountNumber,
 		CurrencyCode:  r.CurrencyCode,
 		Summaries:     r.Summaries,
+		originator:    r.originator,
+		typeCodes:     r.registries.typeCodeRegistry(),
 	}
 
 	r.trailer = accountTrailer{
@@ -67,22 +78,56 @@ func (r *Account) copyRecords() {
 
 }
 
-var accountIdentifierCountExpression = regexp.MustCompile(`(?m:^(?:(?:03)|(?:16)|(?:49)|(?:88)))`)
-
 // Sums the number of 03,16,88,49 records in the account. Maps to the NumberRecords field
 func (a *Account) SumRecords(opts ...int64) int64 {
-	acctString := a.String(opts...)
-	result := accountIdentifierCountExpression.FindAllStringSubmatch(acctString, 10000)
-	return int64(len(result))
+	a.copyRecords()
+
+	sum := countRecords(a.header.string(opts...))
+	for i := range a.Details {
+		sum += countRecords(a.Details[i].String(opts...))
+	}
+	return withTrailer(sum, func(count int64) string {
+		trailer := a.trailer
+		trailer.NumberRecords = count
+		return trailer.string(opts...)
+	})
+}
+
+// countRecords returns the number of physical records in the output of a record writer
+func countRecords(records string) int64 {
+	return int64(strings.Count(records, "\n")) + 1
+}
+
+// withTrailer returns the number of physical records of an envelope made of the given records and its trailer.
+// The trailer counts its own records, which may take continuation records depending on the count written.
+func withTrailer(records int64, trailer func(count int64) string) int64 {
+	count := records + 1
+	for {
+		next := records + countRecords(trailer(count))
+		if next == count {
+			return count
+		}
+		count = next
+	}
 }
 
-// Sums the Amount fields from all 03 and 16 records. Maps to the AccountControlTotal field
+// Sums the Amount fields from all 03 and 16 records, debit details being subtracted as given by the first digit of
+// their type code. This is the net amount of the account, which differs from the AccountControlTotal field: the
+// control total is the algebraic sum of the amounts as written, returned by ControlTotal and set by Finalize.
 func (a *Account) SumDetailAmounts() (string, error) {
-	if err := a.Validate(); err != nil {
+	// the type codes of the details are checked by their first digit below, whatever their level
+	a.copyRecords()
+	if err := a.header.validate(); err != nil {
+		return "0", err
+	}
+	if err := a.trailer.validate(); err != nil {
 		return "0", err
 	}
 	var sum int64
 	for _, detail := range a.Details {
+		if detail.TypeCode == "" {
+			return "0", fmt.Errorf("TypeCode %v is invalid for transaction detail", detail.TypeCode)
+		}
 		amt, err := strconv.ParseInt(detail.Amount, 10, 64)
 		if err != nil {
 			return "0", err
@@ -107,6 +152,162 @@ func (a *Account) SumDetailAmounts() (string, error) {
 	return fmt.Sprint(sum), nil
 }
 
+// Sums the Amount fields from all 03 and 16 records like SumDetailAmounts, debit details being subtracted as given
+// by the direction of their type code in the registry of the originator. Non-monetary information is left out.
+func (a *Account) SumNetDetailAmounts() (string, error) {
+	if err := a.Validate(); err != nil {
+		return "0", err
+	}
+	var sum int64
+	for _, detail := range a.Details {
+		direction := a.TypeCodeDirection(detail.TypeCode)
+		if direction == DirectionNone {
+			if detail.TypeCode == TypeCodeNonMonetaryInformation {
+				continue
+			}
+			return "0", fmt.Errorf("TypeCode %v is invalid for transaction detail", detail.TypeCode)
+		}
+
+		amt, err := strconv.ParseInt(detail.Amount, 10, 64)
+		if err != nil {
+			return "0", err
+		}
+		if direction == DirectionCredit {
+			sum += amt
+		} else {
+			sum -= amt
+		}
+	}
+	for _, summary := range a.Summaries {
+		amt, err := strconv.ParseInt(summary.Amount, 10, 64)
+		if err != nil {
+			return "0", err
+		}
+		sum += amt
+	}
+	return fmt.Sprint(sum), nil
+}
+
+// controlTotal returns the algebraic sum of the Amount fields from all 03 and 16 records,
+// which is how the specification defines the AccountControlTotal field
+func (r *Account) controlTotal() (int64, error) {
+	var sum int64
+	for _, summary := range r.Summaries {
+		amt, err := parseControlTotal(summary.Amount)
+		if err != nil {
+			return 0, fmt.Errorf("invalid amount %q for type code %s", summary.Amount, summary.TypeCode)
+		}
+		sum += amt
+	}
+	for _, detail := range r.Details {
+		amt, err := parseControlTotal(detail.Amount)
+		if err != nil {
+			return 0, fmt.Errorf("invalid amount %q for type code %s", detail.Amount, detail.TypeCode)
+		}
+		sum += amt
+	}
+	return sum, nil
+}
+
+// recordCount returns the number of physical records the account was read from,
+// or the number of records it is written as when it was not read from a file
+func (r *Account) recordCount(opts ...int64) int64 {
+	if r.parsedRecords > 0 {
+		return r.parsedRecords
+	}
+	return r.SumRecords(opts...)
+}
+
+// ValidateIntegrity compares the account trailer with the contents of the account, and returns an ErrorList
+// holding an IntegrityError for every mismatch. The optional argument is the physical record length used
+// to count the records of an account that was not read from a file.
+func (r *Account) ValidateIntegrity(opts ...int64) error {
+	if errs := r.integrityErrors(opts...); len(errs) > 0 {
+		return ErrorList(errs)
+	}
+	return nil
+}
+
+func (r *Account) integrityErrors(opts ...int64) []error {
+	var errs []error
+	location := "account " + r.AccountNumber
+
+	if expected := r.recordCount(opts...); expected != r.NumberRecords {
+		errs = append(errs, &IntegrityError{
+			Record:   util.AccountTrailerCode,
+			Field:    "NumberRecords",
+			Location: location,
+			Expected: fmt.Sprint(expected),
+			Actual:   fmt.Sprint(r.NumberRecords),
+		})
+	}
+
+	expected, err := r.controlTotal()
+	if err != nil {
+		return append(errs, fmt.Errorf("ERROR validating %s in %s (%v)", recordNames[util.AccountTrailerCode], location, err))
+	}
+	if actual, err := parseControlTotal(r.AccountControlTotal); err != nil || actual != expected {
+		errs = append(errs, &IntegrityError{
+			Record:   util.AccountTrailerCode,
+			Field:    "AccountControlTotal",
+			Location: location,
+			Expected: fmt.Sprint(expected),
+			Actual:   r.AccountControlTotal,
+		})
+	}
+
+	return errs
+}
+
+// Finalize sets the AccountControlTotal and NumberRecords fields from the contents of the account.
+// The optional argument is the physical record length used to split records into continuations.
+func (r *Account) Finalize(opts ...int64) error {
+	total, err := r.controlTotal()
+	if err != nil {
+		return fmt.Errorf("ERROR finalizing account %s (%v)", r.AccountNumber, err)
+	}
+
+	r.AccountControlTotal = fmt.Sprint(total)
+	r.NumberRecords = r.SumRecords(opts...)
+	r.parsedRecords = 0
+
+	return nil
+}
+
+// ControlTotal returns the algebraic sum of the Amount fields from all 03 and 16 records in the currency of the account
+func (r *Account) ControlTotal() (Money, error) {
+	total, err := r.controlTotal()
+	if err != nil {
+		return Money{}, err
+	}
+	return NewMoney(total, r.EffectiveCurrency(nil)), nil
+}
+
+// DetailTotals returns the sums of the credit and debit transaction details in the currency of the account
+func (r *Account) DetailTotals() (credits, debits Money, err error) {
+	currency := r.EffectiveCurrency(nil)
+	credits, debits = NewMoney(0, currency), NewMoney(0, currency)
+
+	for i := range r.Details {
+		amount, err := r.Details[i].Money(currency)
+		if err != nil {
+			return credits, debits, fmt.Errorf("%v for type code %s", err, r.Details[i].TypeCode)
+		}
+
+		switch r.TypeCodeDirection(r.Details[i].TypeCode) {
+		case DirectionCredit:
+			credits, err = credits.Add(amount)
+		case DirectionDebit:
+			debits, err = debits.Add(amount)
+		}
+		if err != nil {
+			return credits, debits, fmt.Errorf("%v for type code %s", err, r.Details[i].TypeCode)
+		}
+	}
+
+	return credits, debits, nil
+}
+
 func (r *Account) String(opts ...int64) string {
 
 	r.copyRecords()
@@ -116,14 +317,18 @@ func (r *Account) String(opts ...int64) string {
 	for i := range r.Details {
 		buf.WriteString(r.Details[i].String(opts...) + "\n")
 	}
-	buf.WriteString(r.trailer.string())
+	buf.WriteString(r.trailer.string(opts...))
 
 	return buf.String()
 }
 
-func (r *Account) Validate() error {
+// Validate checks the fields of all records of the account. The options set the registries used to interpret
+// the account instead of those it was read or built with.
+func (r *Account) Validate(opts ...RegistryOption) error {
 
 	r.copyRecords()
+	typeCodes := r.registries.with(opts).typeCodeRegistry()
+	r.header.typeCodes = typeCodes
 
 	if err := r.header.validate(); err != nil {
 		return err
@@ -133,6 +338,9 @@ func (r *Account) Validate() error {
 		if err := r.Details[i].Validate(); err != nil {
 			return err
 		}
+		if code := r.Details[i].TypeCode; code != "" && !typeCodes.allows(r.originator, code, util.TransactionDetailCode) {
+			return newValidationError(tdValidateErrorFmt, "TypeCode")
+		}
 	}
 
 	if err := r.trailer.validate(); err != nil {
@@ -147,6 +355,12 @@ func (r *Account) Read(scan *Bai2Scanner, useCurrentLine bool) error {
 		return errors.New("invalid bai2 scanner")
 	}
 
+	r.registries = scan.registries
+
+	var rawData string
+	var rawLine int
+	find := false
+
 	parseAccountIdentifier := func(raw string) error {
 		if raw == "" {
 			return nil
@@ -155,7 +369,9 @@ func (r *Account) Read(scan *Bai2Scanner, useCurrentLine bool) error {
 		newRecord := accountIdentifier{}
 		_, err := newRecord.parse(raw)
 		if err != nil {
-			return fmt.Errorf("ERROR parsing account identifier on line %d (%v)", scan.GetLineIndex(), err)
+			if err = scan.collect(newParseError("account identifier", rawLine, raw, err)); err != nil {
+				return err
+			}
 		}
 
 		r.AccountNumber = newRecord.AccountNumber
@@ -165,8 +381,19 @@ func (r *Account) Read(scan *Bai2Scanner, useCurrentLine bool) error {
 		return nil
 	}
 
-	var rawData string
-	find := false
+	unexpectedRecord := func(line string) error {
+		err := &fieldError{field: "RecordCode", offset: 0, err: fmt.Errorf("unable to read record type %s", line[0:2])}
+		return scan.collect(newParseError("account", scan.GetLineIndex(), line, err))
+	}
+
+	// The account ends on the first record of the next envelope when its trailer is missing
+	missingTrailer := func(line string) error {
+		if err := parseAccountIdentifier(rawData); err != nil {
+			return err
+		}
+		err := &fieldError{field: "RecordCode", offset: 0, err: errors.New("missing account trailer")}
+		return scan.collect(newParseError("account", scan.GetLineIndex(), line, err))
+	}
 
 	for line := scan.ScanLine(useCurrentLine); line != ""; line = scan.ScanLine(useCurrentLine) {
 		// find record code
@@ -178,15 +405,16 @@ func (r *Account) Read(scan *Bai2Scanner, useCurrentLine bool) error {
 		switch line[:2] {
 		case util.AccountIdentifierCode:
 			if find {
-				break
+				return missingTrailer(line)
 			}
 
 			rawData = line
+			rawLine = scan.GetLineIndex()
 			find = true
 
 		case util.ContinuationCode:
 			if len(rawData) > 0 {
-				rawData = rawData[:len(rawData)-1] + "," + line[3:]
+				rawData = joinContinuation(rawData, line)
 			}
 
 		case util.AccountTrailerCode:
@@ -194,14 +422,22 @@ func (r *Account) Read(scan *Bai2Scanner, useCurrentLine bool) error {
 				return err
 			}
 
+			trailerLine := scan.GetLineIndex()
+			record := scan.readContinuations(line)
+
 			newRecord := accountTrailer{}
-			_, err := newRecord.parse(line)
+			_, err := newRecord.parse(record)
 			if err != nil {
-				return fmt.Errorf("ERROR parsing account trailer on line %d (%v)", scan.GetLineIndex(), err)
+				if err = scan.collect(newParseError("account trailer", trailerLine, record, err)); err != nil {
+					return err
+				}
 			}
 
 			r.AccountControlTotal = newRecord.AccountControlTotal
 			r.NumberRecords = newRecord.NumberRecords
+			if rawLine > 0 {
+				r.parsedRecords = int64(scan.GetLineIndex() - rawLine + 1)
+			}
 
 			return nil
 
@@ -215,16 +451,27 @@ func (r *Account) Read(scan *Bai2Scanner, useCurrentLine bool) error {
 			detail := NewDetail()
 			err := detail.Read(scan, true)
 			if err != nil {
-				return err
+				if err = scan.collect(err); err != nil {
+					return err
+				}
+			} else {
+				r.Details = append(r.Details, *detail)
 			}
 
-			r.Details = append(r.Details, *detail)
 			useCurrentLine = true
+
+		case util.FileHeaderCode, util.GroupHeaderCode, util.GroupTrailerCode, util.FileTrailerCode:
+			return missingTrailer(line)
+
 		default:
-			return fmt.Errorf("ERROR parsing account on line %d (unable to read record type %s)", scan.GetLineIndex(), line[0:2])
+			if err := unexpectedRecord(line); err != nil {
+				return err
+			}
 
+			scan.skipContinuations()
+			useCurrentLine = true
 		}
 	}
 
-	return nil
+	return scan.Err()
 }
@@ -172,4 +172,149 @@ func TestSumAccountTotal(t *testing.T) {
 	sum, err = account.SumDetailAmounts()
 	require.NoError(t, err)
 	require.Equal(t, "0", sum)
+
+	// details without a type code have no direction
+	account.Details = []Detail{{Amount: "27406"}}
+	sum, err = account.SumDetailAmounts()
+	require.EqualError(t, err, "TypeCode  is invalid for transaction detail")
+	require.Equal(t, "0", sum)
+}
+
+func TestSumNetDetailAmounts(t *testing.T) {
+	details := []Detail{}
+	for i := 101; i <= 399; i++ {
+		// summary type codes can not be used in transaction details
+		if definition, ok := LookupTypeCode(strconv.Itoa(i)); ok && definition.Category != CategoryDetail {
+			continue
+		}
+		detail := NewDetail()
+		detail.TypeCode = strconv.Itoa(i)
+		detail.Amount = "27406"
+		detail.BankReferenceNumber = "1234567"
+		detail.Text = "TV Purchase"
+		details = append(details, *detail)
+	}
+	account := Account{}
+	account.AccountNumber = "9876543210"
+	account.Summaries = append(account.Summaries, AccountSummary{
+		TypeCode: "100",
+		Amount:   "20000",
+	})
+	account.Details = details
+	sum, err := account.SumNetDetailAmounts()
+	require.NoError(t, err)
+	require.Equal(t, "6076726", sum)
+
+	details = []Detail{}
+	for i := 401; i <= 699; i++ {
+		// summary type codes can not be used in transaction details
+		if definition, ok := LookupTypeCode(strconv.Itoa(i)); ok && definition.Category != CategoryDetail {
+			continue
+		}
+		detail := NewDetail()
+		detail.TypeCode = strconv.Itoa(i)
+		detail.Amount = "27406"
+		detail.BankReferenceNumber = "1234567"
+		detail.Text = "TV Purchase"
+		details = append(details, *detail)
+	}
+	account = Account{}
+	account.AccountNumber = "9876543210"
+	account.AccountNumber = "9876543210"
+	account.Summaries = append(account.Summaries, AccountSummary{
+		TypeCode: "400",
+		Amount:   "-20000",
+	})
+	account.Details = details
+	sum, err = account.SumNetDetailAmounts()
+	require.NoError(t, err)
+	require.Equal(t, "-6213756", sum)
+
+	details = []Detail{}
+	for i := 101; i <= 699; i++ {
+		// summary type codes can not be used in transaction details
+		if definition, ok := LookupTypeCode(strconv.Itoa(i)); ok && definition.Category != CategoryDetail {
+			continue
+		}
+		detail := NewDetail()
+		detail.TypeCode = strconv.Itoa(i)
+		detail.Amount = "27406"
+		detail.BankReferenceNumber = "1234567"
+		detail.Text = "TV Purchase"
+		details = append(details, *detail)
+	}
+	account = Account{}
+	account.AccountNumber = "9876543210"
+	account.Summaries = append(account.Summaries, AccountSummary{
+		TypeCode: "100",
+		Amount:   "27406",
+	})
+	account.Details = details
+	sum, err = account.SumNetDetailAmounts()
+	require.NoError(t, err)
+	require.Equal(t, "-109624", sum)
+}
+
+func TestAccountRead_ReadError(t *testing.T) {
+	raw := `03,10200123456,CAD,040,+000000000000,,,045,+000000000000,,/
+88,100,000000000208500,00003,V,060316,,400,000000000208500,00008,V,060316,/
+`
+
+	account := Account{}
+	scan := NewBai2Scanner(failingReader(raw))
+
+	err := account.Read(&scan, false)
+	require.ErrorIs(t, err, errTestRead)
+}
+
+func TestAccountValidateIntegrity(t *testing.T) {
+	account := Account{
+		AccountNumber: "9876543210",
+		Summaries: []AccountSummary{
+			{TypeCode: "010", Amount: "-500000"},
+			{TypeCode: "100", Amount: "1000000", ItemCount: 2},
+		},
+		Details: []Detail{
+			{TypeCode: "115", Amount: "500000", Text: "LOCK BOX NO.68751"},
+			{TypeCode: "495", Amount: "250000"},
+		},
+		AccountControlTotal: "1250000",
+		NumberRecords:       4,
+	}
+	require.NoError(t, account.ValidateIntegrity())
+
+	// a shorter physical record length splits the account identifier into continuation records
+	err := account.ValidateIntegrity(40)
+	require.EqualError(t, err, "ERROR validating account trailer in account 9876543210 (NumberRecords is 4, expected 5)")
+
+	account.AccountControlTotal = "250000"
+	account.NumberRecords = 5
+	err = account.ValidateIntegrity(40)
+	require.EqualError(t, err, "ERROR validating account trailer in account 9876543210 (AccountControlTotal is 250000, expected 1250000)")
+}
+
+func TestAccountFinalize(t *testing.T) {
+	raw := `
+03,9876543210,,010,-500000,,,100,1000000,,,400,2000000,,,190/
+88,500000,,,110,1000000,,,072,500000,,,074,500000,,,040/
+88,-1500000,,/
+16,115,500000,S,,200000,300000,,,LOCK BOX NO.68751/
+49,0,1/
+`
+
+	scan := NewBai2Scanner(bytes.NewReader([]byte(raw)))
+	account := Account{}
+	require.NoError(t, account.Read(&scan, false))
+
+	require.NoError(t, account.Finalize())
+	require.Equal(t, "4000000", account.AccountControlTotal)
+	require.Equal(t, int64(3), account.NumberRecords)
+	require.NoError(t, account.ValidateIntegrity())
+
+	require.NoError(t, account.Finalize(50))
+	require.Equal(t, int64(6), account.NumberRecords)
+	require.NoError(t, account.ValidateIntegrity(50))
+
+	account.Details[0].Amount = "invalid"
+	require.EqualError(t, account.Finalize(), `ERROR finalizing account 9876543210 (invalid amount "invalid" for type code 115)`)
 }
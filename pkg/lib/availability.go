@@ -0,0 +1,162 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+/*
+
+AVAILABILITY
+
+The funds type of a summary or transaction detail tells when its amount becomes available:
+
+	0  immediately, on the as-of date
+	1  after one day
+	2  after two or more days, which is scheduled after two days
+	S  immediate, one-day and two or more days amounts
+	V  on the value date and time
+	D  distributed over a number of days
+	Z  unknown, which is also the meaning of an omitted funds type
+
+Days are calendar days counted from the as-of date of the group. Amounts of unknown availability are not
+scheduled.
+
+*/
+
+// Availability is an amount that becomes available at a date
+type Availability struct {
+	Date   time.Time `json:"date"`
+	Amount Money     `json:"amount"`
+}
+
+// Schedule returns when the amount with the funds type becomes available, relative to the as-of date of
+// its group. The amounts of S and D funds types are reported in the funds type, in the currency of amount.
+func (f *FundsType) Schedule(amount Money, asOf time.Time) ([]Availability, error) {
+	if err := f.TypeCode.Validate(); err != nil {
+		return nil, err
+	}
+
+	day := time.Date(asOf.Year(), asOf.Month(), asOf.Day(), 0, 0, 0, 0, asOf.Location())
+	after := func(days int64, value int64) Availability {
+		return Availability{Date: day.AddDate(0, 0, int(days)), Amount: NewMoney(value, amount.Currency)}
+	}
+
+	var schedule []Availability
+	switch strings.ToUpper(string(f.TypeCode)) {
+	case FundsType0:
+		schedule = append(schedule, after(0, amount.Amount))
+	case FundsType1:
+		schedule = append(schedule, after(1, amount.Amount))
+	case FundsType2:
+		schedule = append(schedule, after(2, amount.Amount))
+	case FundsTypeS:
+		for days, value := range []int64{f.ImmediateAmount, f.OneDayAmount, f.TwoDayAmount} {
+			if value != 0 {
+				schedule = append(schedule, after(int64(days), value))
+			}
+		}
+	case FundsTypeV:
+		date, err := f.ValueDate(asOf.Location())
+		if err != nil {
+			return nil, err
+		}
+		schedule = append(schedule, Availability{Date: date, Amount: amount})
+	case FundsTypeD:
+		for _, distribution := range f.Distributions {
+			schedule = append(schedule, after(distribution.Day, distribution.Amount))
+		}
+	}
+
+	return schedule, nil
+}
+
+// Availability returns when the amount of the summary becomes available, relative to the as-of date of its group
+func (s AccountSummary) Availability(asOf time.Time, currency string) ([]Availability, error) {
+	amount, err := s.Money(currency)
+	if err != nil {
+		return nil, err
+	}
+	return s.FundsType.Schedule(amount, asOf)
+}
+
+// Availability returns when the amount of the detail becomes available, relative to the as-of date of its group
+func (r *Detail) Availability(asOf time.Time, currency string) ([]Availability, error) {
+	amount, err := r.Money(currency)
+	if err != nil {
+		return nil, err
+	}
+	return r.FundsType.Schedule(amount, asOf)
+}
+
+// AvailabilitySchedule returns the net amounts that become available to the account at every date, ordered
+// by date, relative to the as-of date of its group. It aggregates the availability of the transaction details,
+// in which debits reduce the amount available, by calendar date: value times are dropped and every date is
+// returned at midnight in the location of asOf. The funds types of the account summaries are not included, as
+// summaries report totals of the transaction details.
+func (r *Account) AvailabilitySchedule(asOf time.Time) ([]Availability, error) {
+	currency := r.EffectiveCurrency(nil)
+
+	// net amounts by calendar date, keyed as YYYYMMDD
+	amounts := make(map[int]int64)
+
+	for i := range r.Details {
+		detail := &r.Details[i]
+
+		direction := r.TypeCodeDirection(detail.TypeCode)
+		if direction == DirectionNone {
+			continue
+		}
+
+		schedule, err := detail.Availability(asOf, currency)
+		if err != nil {
+			return nil, fmt.Errorf("%v for type code %s", err, detail.TypeCode)
+		}
+		for _, availability := range schedule {
+			if direction == DirectionDebit {
+				availability.Amount = availability.Amount.Neg()
+			}
+			year, month, day := availability.Date.Date()
+			amounts[year*10000+int(month)*100+day] += availability.Amount.Amount
+		}
+	}
+
+	schedule := make([]Availability, 0, len(amounts))
+	for date, amount := range amounts {
+		day := time.Date(date/10000, time.Month(date/100%100), date%100, 0, 0, 0, 0, asOf.Location())
+		schedule = append(schedule, Availability{Date: day, Amount: NewMoney(amount, currency)})
+	}
+	sort.Slice(schedule, func(i, j int) bool {
+		return schedule[i].Date.Before(schedule[j].Date)
+	})
+
+	return schedule, nil
+}
+
+// AvailabilitySchedules returns the availability schedule of every account of the group, by account number,
+// relative to the as-of date of the group
+func (r *Group) AvailabilitySchedules() (map[string][]Availability, error) {
+	// an end of day as-of time does not move the schedules to the following day
+	asOf, err := r.AsOfDay()
+	if err != nil {
+		return nil, err
+	}
+
+	schedules := make(map[string][]Availability, len(r.Accounts))
+	for i := range r.Accounts {
+		account := &r.Accounts[i]
+		schedule, err := account.AvailabilitySchedule(asOf)
+		if err != nil {
+			return nil, fmt.Errorf("%v for account %s", err, account.AccountNumber)
+		}
+		schedules[account.AccountNumber] = schedule
+	}
+
+	return schedules, nil
+}
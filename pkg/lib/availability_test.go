@@ -0,0 +1,98 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFundsTypeSchedule(t *testing.T) {
+	asOf := time.Date(2006, time.March, 17, 8, 0, 0, 0, time.UTC)
+	day := func(d int) time.Time {
+		return time.Date(2006, time.March, d, 0, 0, 0, 0, time.UTC)
+	}
+	amount := NewMoney(10000, "USD")
+
+	testCases := []struct {
+		funds    FundsType
+		expected []Availability
+	}{
+		{FundsType{}, nil},
+		{FundsType{TypeCode: FundsTypeZ}, nil},
+		{FundsType{TypeCode: FundsType0}, []Availability{{day(17), amount}}},
+		{FundsType{TypeCode: FundsType1}, []Availability{{day(18), amount}}},
+		{FundsType{TypeCode: FundsType2}, []Availability{{day(19), amount}}},
+		{
+			FundsType{TypeCode: FundsTypeS, ImmediateAmount: 6000, TwoDayAmount: 4000},
+			[]Availability{{day(17), NewMoney(6000, "USD")}, {day(19), NewMoney(4000, "USD")}},
+		},
+		{
+			FundsType{TypeCode: FundsTypeV, Date: "060320", Time: "1300"},
+			[]Availability{{time.Date(2006, time.March, 20, 13, 0, 0, 0, time.UTC), amount}},
+		},
+		{
+			FundsType{TypeCode: FundsTypeD, DistributionNumber: 2, Distributions: []Distribution{{Day: 1, Amount: 3000}, {Day: 5, Amount: 7000}}},
+			[]Availability{{day(18), NewMoney(3000, "USD")}, {day(22), NewMoney(7000, "USD")}},
+		},
+	}
+
+	for _, tc := range testCases {
+		schedule, err := tc.funds.Schedule(amount, asOf)
+		require.NoError(t, err, tc.funds.String())
+		require.Equal(t, tc.expected, schedule, tc.funds.String())
+	}
+
+	funds := FundsType{TypeCode: "X"}
+	_, err := funds.Schedule(amount, asOf)
+	require.EqualError(t, err, "invalid fund type")
+}
+
+func TestAccountAvailabilitySchedule(t *testing.T) {
+	raw := `01,0004,12345,060321,0829,001,80,1,2/
+02,12345,0004,1,060317,2400,,/
+03,10200123456,,010,+100000,,/
+16,115,10000,S,5000,4000,1000,,,LOCK BOX/
+16,195,2500,1,,,/
+16,475,500,0,,,/
+16,890,,,,,NON MONETARY/
+16,195,300,V,060319,1200,,,/
+49,113300,7/
+03,10200123457,,015,100,,/
+16,195,700,V,060321,,,,/
+49,800,3/
+98,114100,2,12/
+99,114100,1,14/`
+
+	scan := NewBai2Scanner(strings.NewReader(raw))
+	file := NewBai2()
+	require.NoError(t, file.Read(&scan))
+
+	schedules, err := file.Groups[0].AvailabilitySchedules()
+	require.NoError(t, err)
+
+	// schedules are relative to the as-of date whatever the as-of time, even 2400, and amounts available
+	// at a value time are scheduled on the date they become available
+	day := func(d int) time.Time {
+		return time.Date(2006, time.March, d, 0, 0, 0, 0, time.UTC)
+	}
+	require.Equal(t, map[string][]Availability{
+		"10200123456": {
+			{day(17), NewMoney(4500, "USD")},
+			{day(18), NewMoney(6500, "USD")},
+			{day(19), NewMoney(1300, "USD")},
+		},
+		"10200123457": {
+			{day(21), NewMoney(700, "USD")},
+		},
+	}, schedules)
+
+	file.Groups[0].Accounts[0].Details[1].FundsType.TypeCode = "X"
+	_, err = file.Groups[0].AvailabilitySchedules()
+	require.EqualError(t, err, "invalid fund type for type code 195 for account 10200123456")
+}
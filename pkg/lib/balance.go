@@ -0,0 +1,81 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+// Balance returns the amount of the first status summary of the account with the type code, in the
+// effective currency of the account. The balance is not found when the account does not report it,
+// reports it without an amount, or when the type code is not a status code of the originator.
+func (r *Account) Balance(typeCode string) (Money, bool) {
+	definition, ok := r.LookupTypeCode(typeCode)
+	if !ok || definition.Category != CategoryStatus {
+		return Money{}, false
+	}
+
+	for _, summary := range r.Summaries {
+		if summary.TypeCode != typeCode {
+			continue
+		}
+		if summary.Amount == "" {
+			return Money{}, false
+		}
+		amount, err := summary.Money(r.EffectiveCurrency(nil))
+		if err != nil {
+			return Money{}, false
+		}
+		return amount, true
+	}
+
+	return Money{}, false
+}
+
+// OpeningLedger returns the opening ledger balance (010) of the account
+func (r *Account) OpeningLedger() (Money, bool) {
+	return r.Balance(TypeCodeOpeningLedger)
+}
+
+// ClosingLedger returns the closing ledger balance (015) of the account
+func (r *Account) ClosingLedger() (Money, bool) {
+	return r.Balance(TypeCodeClosingLedger)
+}
+
+// CurrentLedger returns the current ledger balance (030) of the account
+func (r *Account) CurrentLedger() (Money, bool) {
+	return r.Balance(TypeCodeCurrentLedger)
+}
+
+// OpeningAvailable returns the opening available balance (040) of the account
+func (r *Account) OpeningAvailable() (Money, bool) {
+	return r.Balance(TypeCodeOpeningAvailable)
+}
+
+// ClosingAvailable returns the closing available balance (045) of the account
+func (r *Account) ClosingAvailable() (Money, bool) {
+	return r.Balance(TypeCodeClosingAvailable)
+}
+
+// CurrentAvailable returns the current available balance (060) of the account
+func (r *Account) CurrentAvailable() (Money, bool) {
+	return r.Balance(TypeCodeCurrentAvailable)
+}
+
+// TotalFloat returns the total float (063) of the account
+func (r *Account) TotalFloat() (Money, bool) {
+	return r.Balance(TypeCodeTotalFloat)
+}
+
+// ZeroDayFloat returns the 0-day float (070) of the account
+func (r *Account) ZeroDayFloat() (Money, bool) {
+	return r.Balance(TypeCodeZeroDayFloat)
+}
+
+// OneDayFloat returns the 1-day float (072) of the account
+func (r *Account) OneDayFloat() (Money, bool) {
+	return r.Balance(TypeCodeOneDayFloat)
+}
+
+// TwoOrMoreDaysFloat returns the 2 or more days float (074) of the account
+func (r *Account) TwoOrMoreDaysFloat() (Money, bool) {
+	return r.Balance(TypeCodeTwoOrMoreDaysFloat)
+}
@@ -0,0 +1,75 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountBalances(t *testing.T) {
+	raw := `01,0004,12345,060321,0829,001,80,1,2/
+02,12345,0004,1,060317,,CAD,/
+03,10200123456,,010,+100000,,,015,-2500,,,040,,,,072,300,,,100,90000,1,/
+16,115,90000,,,,LOCK BOX/
+49,187800,3/
+98,187800,1,5/
+99,187800,1,7/`
+
+	scan := NewBai2Scanner(strings.NewReader(raw))
+	file := NewBai2()
+	require.NoError(t, file.Read(&scan))
+
+	account := &file.Groups[0].Accounts[0]
+
+	balance, ok := account.OpeningLedger()
+	require.True(t, ok)
+	require.Equal(t, NewMoney(100000, "CAD"), balance)
+
+	balance, ok = account.ClosingLedger()
+	require.True(t, ok)
+	require.Equal(t, "-25.00 CAD", balance.String())
+
+	balance, ok = account.OneDayFloat()
+	require.True(t, ok)
+	require.Equal(t, NewMoney(300, "CAD"), balance)
+
+	// reported without an amount
+	_, ok = account.OpeningAvailable()
+	require.False(t, ok)
+
+	// not reported
+	_, ok = account.ClosingAvailable()
+	require.False(t, ok)
+
+	// summary codes are not balances
+	_, ok = account.Balance(TypeCodeTotalCredits)
+	require.False(t, ok)
+	_, ok = account.Balance("999")
+	require.False(t, ok)
+
+	account.CurrencyCode = "JPY"
+	balance, ok = account.OpeningLedger()
+	require.True(t, ok)
+	require.Equal(t, "100000 JPY", balance.String())
+}
+
+func TestAccountCustomBalance(t *testing.T) {
+	registry := NewTypeCodeRegistry()
+	require.NoError(t, registry.Register("12345", TypeCodeDefinition{Code: "905", Name: "Collected Balance"}))
+
+	account := Account{
+		AccountNumber: "10200123456",
+		Summaries:     []AccountSummary{{TypeCode: "905", Amount: "125000"}},
+		originator:    "12345",
+	}
+
+	account.UseRegistries(UseTypeCodes(registry))
+	balance, ok := account.Balance("905")
+	require.True(t, ok)
+	require.Equal(t, NewMoney(125000, "USD"), balance)
+}
@@ -0,0 +1,309 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/moov-io/bai2/pkg/util"
+)
+
+const (
+	fbErrorFmt        = "FileBuilder: invalid %s"
+	fbMissingGroupMsg = "FileBuilder: %s called before Group"
+	fbMissingAcctMsg  = "FileBuilder: %s called before Account"
+	fbDefaultVersion  = 2
+)
+
+// DetailOption sets an optional field of a transaction detail added by FileBuilder.Credit or FileBuilder.Debit
+type DetailOption func(*Detail)
+
+// WithBankReference sets the bank reference number of a transaction detail
+func WithBankReference(reference string) DetailOption {
+	return func(d *Detail) {
+		d.BankReferenceNumber = reference
+	}
+}
+
+// WithCustomerReference sets the customer reference number of a transaction detail
+func WithCustomerReference(reference string) DetailOption {
+	return func(d *Detail) {
+		d.CustomerReferenceNumber = reference
+	}
+}
+
+// WithText sets the free form text of a transaction detail
+func WithText(text string) DetailOption {
+	return func(d *Detail) {
+		d.Text = text
+	}
+}
+
+// WithFundsType sets the funds type of a transaction detail
+func WithFundsType(fundsType FundsType) DetailOption {
+	return func(d *Detail) {
+		d.FundsType = fundsType
+	}
+}
+
+// FileBuilder constructs a Bai2 file from typed values.
+//
+// Every method returns the builder so calls can be chained. Values are validated as they are added and
+// the first error is kept and returned by Build, every later call being ignored. Groups and accounts are
+// added to the most recent file and group respectively.
+type FileBuilder struct {
+	file    Bai2
+	created time.Time
+	err     error
+}
+
+func NewFileBuilder() *FileBuilder {
+	return &FileBuilder{
+		file: Bai2{VersionNumber: fbDefaultVersion},
+	}
+}
+
+// Sender sets the sender identification of the file header
+func (b *FileBuilder) Sender(id string) *FileBuilder {
+	if b.check(id != "", "Sender") {
+		b.file.Sender = id
+	}
+	return b
+}
+
+// Receiver sets the receiver identification of the file header, which is also the default receiver of every group
+func (b *FileBuilder) Receiver(id string) *FileBuilder {
+	if b.check(id != "", "Receiver") {
+		b.file.Receiver = id
+	}
+	return b
+}
+
+// Created sets the file creation date and time, which is written in the time zone of the sender
+func (b *FileBuilder) Created(t time.Time) *FileBuilder {
+	if b.check(!t.IsZero(), "FileCreatedDate") {
+		b.created = t
+	}
+	return b
+}
+
+// FileID sets the file identification number
+func (b *FileBuilder) FileID(id string) *FileBuilder {
+	if b.check(id != "", "FileIdNumber") {
+		b.file.FileIdNumber = id
+	}
+	return b
+}
+
+// PhysicalRecordLength sets the physical record length, records longer than it being split into continuations
+func (b *FileBuilder) PhysicalRecordLength(length int64) *FileBuilder {
+	if b.check(length >= 0, "PhysicalRecordLength") {
+		b.file.PhysicalRecordLength = length
+	}
+	return b
+}
+
+// BlockSize sets the number of physical records in a block
+func (b *FileBuilder) BlockSize(size int64) *FileBuilder {
+	if b.check(size >= 0, "BlockSize") {
+		b.file.BlockSize = size
+	}
+	return b
+}
+
+// Registries sets the registries used to validate the type codes of the file and to convert its dates and times,
+// instead of CustomTypeCodes and TimeZones. It needs to be called before adding groups.
+func (b *FileBuilder) Registries(opts ...RegistryOption) *FileBuilder {
+	if b.check(len(b.file.Groups) == 0, "Registries") {
+		b.file.UseRegistries(opts...)
+	}
+	return b
+}
+
+// Group starts a new group from the originator, with information as of the given date and time, which is
+// written in the time zone of the originator. The group status defaults to update and the receiver to the
+// receiver of the file.
+func (b *FileBuilder) Group(originator string, asOf time.Time) *FileBuilder {
+	if b.check(originator != "", "Originator") && b.check(!asOf.IsZero(), "AsOfDate") {
+		group := Group{
+			Originator:  originator,
+			GroupStatus: GroupStatusUpdate,
+			registries:  b.file.registries,
+		}
+		group.SetAsOf(asOf)
+		b.file.Groups = append(b.file.Groups, group)
+	}
+	return b
+}
+
+// GroupReceiver sets the ultimate receiver of the current group
+func (b *FileBuilder) GroupReceiver(id string) *FileBuilder {
+	if group := b.group("GroupReceiver"); group != nil && b.check(id != "", "Receiver") {
+		group.Receiver = id
+	}
+	return b
+}
+
+// GroupStatus sets the status of the current group
+func (b *FileBuilder) GroupStatus(status GroupStatus) *FileBuilder {
+	if group := b.group("GroupStatus"); group != nil && b.check(status.Valid(), "GroupStatus") {
+		group.GroupStatus = status
+	}
+	return b
+}
+
+// GroupCurrency sets the currency code of the current group, which is the default currency of its accounts
+func (b *FileBuilder) GroupCurrency(code string) *FileBuilder {
+	if group := b.group("GroupCurrency"); group != nil && b.check(util.ValidateCurrencyCode(code), "CurrencyCode") {
+		group.CurrencyCode = code
+	}
+	return b
+}
+
+// AsOfDateModifier sets the as-of-date modifier of the current group
+func (b *FileBuilder) AsOfDateModifier(modifier AsOfDateModifier) *FileBuilder {
+	if group := b.group("AsOfDateModifier"); group != nil && b.check(modifier.Valid(), "AsOfDateModifier") {
+		group.AsOfDateModifier = modifier
+	}
+	return b
+}
+
+// Account starts a new account in the current group
+func (b *FileBuilder) Account(number string) *FileBuilder {
+	if group := b.group("Account"); group != nil && b.check(number != "", "AccountNumber") {
+		group.Accounts = append(group.Accounts, Account{
+			AccountNumber: number,
+			originator:    group.Originator,
+			groupCurrency: group.CurrencyCode,
+			registries:    group.registries,
+		})
+	}
+	return b
+}
+
+// AccountCurrency sets the currency code of the current account
+func (b *FileBuilder) AccountCurrency(code string) *FileBuilder {
+	if account := b.account("AccountCurrency"); account != nil && b.check(util.ValidateCurrencyCode(code), "CurrencyCode") {
+		account.CurrencyCode = code
+	}
+	return b
+}
+
+// Summary adds a status or summary amount, in minor units, to the account identifier of the current account.
+// The item count is omitted when zero.
+func (b *FileBuilder) Summary(typeCode string, amount int64, itemCount int64) *FileBuilder {
+	account := b.account("Summary")
+	if account == nil || !b.check(account.registries.typeCodeRegistry().allows(account.originator, typeCode, util.AccountIdentifierCode), "TypeCode") || !b.check(itemCount >= 0, "ItemCount") {
+		return b
+	}
+
+	account.Summaries = append(account.Summaries, AccountSummary{
+		TypeCode:  typeCode,
+		Amount:    fmt.Sprint(amount),
+		ItemCount: itemCount,
+	})
+	return b
+}
+
+// Credit adds a credit transaction detail, with an amount in minor units, to the current account
+func (b *FileBuilder) Credit(typeCode string, amount int64, opts ...DetailOption) *FileBuilder {
+	return b.detail("Credit", typeCode, DirectionCredit, amount, opts)
+}
+
+// Debit adds a debit transaction detail, with an amount in minor units, to the current account
+func (b *FileBuilder) Debit(typeCode string, amount int64, opts ...DetailOption) *FileBuilder {
+	return b.detail("Debit", typeCode, DirectionDebit, amount, opts)
+}
+
+// Build finalizes the trailers of the file and validates it
+func (b *FileBuilder) Build() (*Bai2, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	file := b.file
+	if !b.created.IsZero() {
+		file.SetFileCreated(b.created)
+	}
+	file.Groups = make([]Group, len(b.file.Groups))
+	for i, group := range b.file.Groups {
+		if group.Receiver == "" {
+			group.Receiver = file.Receiver
+		}
+		group.Accounts = append([]Account(nil), group.Accounts...)
+		file.Groups[i] = group
+	}
+
+	if err := file.Finalize(); err != nil {
+		return nil, err
+	}
+	if err := file.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &file, nil
+}
+
+func (b *FileBuilder) detail(method, typeCode string, direction TransactionDirection, amount int64, opts []DetailOption) *FileBuilder {
+	account := b.account(method)
+	if account == nil {
+		return b
+	}
+
+	valid := account.registries.typeCodeRegistry().allows(account.originator, typeCode, util.TransactionDetailCode) &&
+		account.TypeCodeDirection(typeCode) == direction
+	if !b.check(valid, "TypeCode") || !b.check(amount >= 0, "Amount") {
+		return b
+	}
+
+	detail := Detail{
+		TypeCode: typeCode,
+		Amount:   fmt.Sprint(amount),
+	}
+	for _, opt := range opts {
+		opt(&detail)
+	}
+	if !b.check(detail.Validate() == nil, "Detail") {
+		return b
+	}
+
+	account.Details = append(account.Details, detail)
+	return b
+}
+
+// check records an error for the field unless valid, and reports whether the builder has no error
+func (b *FileBuilder) check(valid bool, field string) bool {
+	if b.err != nil {
+		return false
+	}
+	if !valid {
+		b.err = fmt.Errorf(fbErrorFmt, field)
+	}
+	return b.err == nil
+}
+
+func (b *FileBuilder) group(method string) *Group {
+	if b.err != nil {
+		return nil
+	}
+	if len(b.file.Groups) == 0 {
+		b.err = fmt.Errorf(fbMissingGroupMsg, method)
+		return nil
+	}
+	return &b.file.Groups[len(b.file.Groups)-1]
+}
+
+func (b *FileBuilder) account(method string) *Account {
+	group := b.group(method)
+	if group == nil {
+		return nil
+	}
+	if len(group.Accounts) == 0 {
+		b.err = fmt.Errorf(fbMissingAcctMsg, method)
+		return nil
+	}
+	return &group.Accounts[len(group.Accounts)-1]
+}
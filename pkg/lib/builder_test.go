@@ -0,0 +1,90 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileBuilder(t *testing.T) {
+	created := time.Date(2006, time.March, 21, 8, 29, 0, 0, time.UTC)
+	asOf := time.Date(2006, time.March, 17, 0, 0, 0, 0, time.UTC)
+
+	file, err := NewFileBuilder().
+		Sender("0004").
+		Receiver("12345").
+		Created(created).
+		FileID("001").
+		PhysicalRecordLength(80).
+		BlockSize(1).
+		Group("0004", asOf).
+		GroupCurrency("CAD").
+		AsOfDateModifier(2).
+		Account("10200123456").
+		Summary(TypeCodeOpeningLedger, 100000, 0).
+		Summary(TypeCodeTotalCredits, 92500, 2).
+		Summary(TypeCodeTotalDebits, 2500, 1).
+		Credit(TypeCodeLockboxDeposit, 90000, WithBankReference("1234567"), WithText("LOCK BOX NO.68751")).
+		Credit(TypeCodeIncomingMoneyTransfer, 2500, WithCustomerReference("INV-1")).
+		Debit(TypeCodeCheckPaid, 2500, WithFundsType(FundsType{TypeCode: FundsType0})).
+		Account("10200123457").
+		AccountCurrency("USD").
+		Summary(TypeCodeClosingLedger, -500, 0).
+		Build()
+	require.NoError(t, err)
+	require.NoError(t, file.ValidateIntegrity())
+
+	expected := `01,0004,12345,060321,0829,001,80,1,2/
+02,12345,0004,1,060317,0000,CAD,2/
+03,10200123456,,010,100000,,,100,92500,2,,400,2500,1,/
+16,115,90000,,1234567,,LOCK BOX NO.68751/
+16,195,2500,,,INV-1,/
+16,475,2500,0,,,/
+49,290000,5/
+03,10200123457,USD,015,-500,,/
+49,-500,2/
+98,289500,2,9/
+99,289500,1,11/`
+	require.Equal(t, expected, file.String())
+
+	scan := NewBai2Scanner(strings.NewReader(file.String()))
+	read := NewBai2()
+	require.NoError(t, read.Read(&scan))
+	require.NoError(t, read.ValidateIntegrity())
+}
+
+func TestFileBuilder_Errors(t *testing.T) {
+	asOf := time.Date(2006, time.March, 17, 0, 0, 0, 0, time.UTC)
+
+	_, err := NewFileBuilder().Sender("").Receiver("12345").Build()
+	require.EqualError(t, err, "FileBuilder: invalid Sender")
+
+	_, err = NewFileBuilder().Sender("0004").Account("10200123456").Build()
+	require.EqualError(t, err, "FileBuilder: Account called before Group")
+
+	_, err = NewFileBuilder().Sender("0004").Group("0004", asOf).Credit(TypeCodeLockboxDeposit, 100).Build()
+	require.EqualError(t, err, "FileBuilder: Credit called before Account")
+
+	_, err = NewFileBuilder().Group("0004", asOf).Account("1").Credit(TypeCodeCheckPaid, 100).Build()
+	require.EqualError(t, err, "FileBuilder: invalid TypeCode")
+
+	_, err = NewFileBuilder().Group("0004", asOf).Account("1").Debit(TypeCodeCheckPaid, -100).Build()
+	require.EqualError(t, err, "FileBuilder: invalid Amount")
+
+	_, err = NewFileBuilder().Group("0004", asOf).GroupCurrency("CA").Build()
+	require.EqualError(t, err, "FileBuilder: invalid CurrencyCode")
+
+	// the first error is kept
+	_, err = NewFileBuilder().Sender("").Receiver("").Build()
+	require.EqualError(t, err, "FileBuilder: invalid Sender")
+
+	// fields that are not set are reported by validation
+	_, err = NewFileBuilder().Sender("0004").Receiver("12345").FileID("001").Build()
+	require.EqualError(t, err, "FileHeader: invalid FileCreatedDate")
+}
@@ -0,0 +1,164 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+/*
+
+CURRENCY
+
+The currency code of a group applies to all its accounts, unless an account identifier reports its own
+currency code. A group without a currency code is reported in DefaultCurrency. The currency of an account
+applies to all the amounts of the account: its summaries, transaction details and funds type amounts.
+
+*/
+
+// EffectiveCurrency returns the currency of the amounts of the group
+func (r *Group) EffectiveCurrency() string {
+	return NewMoney(0, r.CurrencyCode).Currency
+}
+
+// EffectiveCurrency returns the currency of the amounts of the account, which is inherited from the group
+// when the account identifier does not report a currency code. When group is nil, the currency of the
+// group the account was read from or last resolved with is used.
+func (r *Account) EffectiveCurrency(group *Group) string {
+	switch {
+	case r.CurrencyCode != "":
+		return NewMoney(0, r.CurrencyCode).Currency
+	case group != nil:
+		return group.EffectiveCurrency()
+	}
+	return NewMoney(0, r.groupCurrency).Currency
+}
+
+// Currency returns the effective currency of the detail, which is resolved when the file is read
+// or by ResolveCurrencies. It is empty for details that have not been resolved.
+func (r *Detail) Currency() string {
+	return r.currency
+}
+
+// ResolveCurrencies resolves the effective currency of every account and detail of the file.
+// It is done by Read, and needs to be repeated after changing currency codes or adding accounts or details.
+func (r *Bai2) ResolveCurrencies() {
+	for i := range r.Groups {
+		r.Groups[i].ResolveCurrencies()
+	}
+}
+
+// ResolveCurrencies resolves the effective currency of every account and detail of the group
+func (r *Group) ResolveCurrencies() {
+	for i := range r.Accounts {
+		r.Accounts[i].groupCurrency = r.CurrencyCode
+		r.Accounts[i].resolveCurrencies()
+	}
+}
+
+func (r *Account) resolveCurrencies() {
+	currency := r.EffectiveCurrency(nil)
+	for i := range r.Details {
+		r.Details[i].currency = currency
+	}
+}
+
+// ControlTotalsByCurrency returns the sums of the account control totals of the group for every currency
+func (r *Group) ControlTotalsByCurrency() (map[string]Money, error) {
+	totals := make(map[string]Money)
+	if err := r.addControlTotals(totals); err != nil {
+		return nil, err
+	}
+	return totals, nil
+}
+
+// ControlTotalsByCurrency returns the sums of the account control totals of the file for every currency
+func (r *Bai2) ControlTotalsByCurrency() (map[string]Money, error) {
+	totals := make(map[string]Money)
+	for i := range r.Groups {
+		if err := r.Groups[i].addControlTotals(totals); err != nil {
+			return nil, err
+		}
+	}
+	return totals, nil
+}
+
+func (r *Group) addControlTotals(totals map[string]Money) error {
+	for i := range r.Accounts {
+		account := &r.Accounts[i]
+		currency := account.EffectiveCurrency(r)
+
+		amount, err := ParseMoney(account.AccountControlTotal, currency)
+		if err != nil {
+			return fmt.Errorf("%v for account %s", err, account.AccountNumber)
+		}
+
+		total, ok := totals[currency]
+		if !ok {
+			total = NewMoney(0, currency)
+		}
+		totals[currency], _ = total.Add(amount)
+	}
+	return nil
+}
+
+// ControlTotal returns the sum of the account control totals of the group in the currency of its accounts.
+// Groups holding accounts of several currencies are summed by ControlTotalsByCurrency.
+func (r *Group) ControlTotal() (Money, error) {
+	totals, err := r.ControlTotalsByCurrency()
+	if err != nil {
+		return Money{}, err
+	}
+	return singleCurrency(totals, r.EffectiveCurrency())
+}
+
+// ControlTotal returns the sum of the account control totals of the file in the currency of its accounts.
+// Files holding accounts of several currencies are summed by ControlTotalsByCurrency.
+func (r *Bai2) ControlTotal() (Money, error) {
+	totals, err := r.ControlTotalsByCurrency()
+	if err != nil {
+		return Money{}, err
+	}
+	return singleCurrency(totals, DefaultCurrency)
+}
+
+// singleCurrency returns the only total of the map, or a zero amount in the currency when the map is empty
+func singleCurrency(totals map[string]Money, currency string) (Money, error) {
+	switch len(totals) {
+	case 0:
+		return NewMoney(0, currency), nil
+	case 1:
+		for _, total := range totals {
+			return total, nil
+		}
+	}
+
+	currencies := make([]string, 0, len(totals))
+	for currency := range totals {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+	return Money{}, fmt.Errorf("unable to sum amounts of currencies %s", strings.Join(currencies, ", "))
+}
+
+// DetailAmount returns the amount summed by SumDetailAmounts in the currency of the account
+func (a *Account) DetailAmount() (Money, error) {
+	sum, err := a.SumDetailAmounts()
+	if err != nil {
+		return Money{}, err
+	}
+	return ParseMoney(sum, a.EffectiveCurrency(nil))
+}
+
+// NetDetailAmount returns the amount summed by SumNetDetailAmounts in the currency of the account
+func (a *Account) NetDetailAmount() (Money, error) {
+	sum, err := a.SumNetDetailAmounts()
+	if err != nil {
+		return Money{}, err
+	}
+	return ParseMoney(sum, a.EffectiveCurrency(nil))
+}
@@ -0,0 +1,87 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const multiCurrencySample = `01,0004,12345,060321,0829,001,80,1,2/
+02,12345,0004,1,060317,,CAD,/
+03,10200123456,,010,+100000,,/
+16,115,90000,,,,LOCK BOX/
+49,190000,3/
+03,10200123457,USD,015,-1000,,/
+16,475,500,,,,/
+49,-500,3/
+98,189500,2,8/
+02,12345,0004,1,060317,,,/
+03,10200123458,,015,2500,,/
+49,2500,2/
+98,2500,1,4/
+99,192000,2,14/`
+
+func TestEffectiveCurrency(t *testing.T) {
+	scan := NewBai2Scanner(strings.NewReader(multiCurrencySample))
+	file := NewBai2()
+	require.NoError(t, file.Read(&scan))
+
+	cad, usd := &file.Groups[0], &file.Groups[1]
+	require.Equal(t, "CAD", cad.EffectiveCurrency())
+	require.Equal(t, "USD", usd.EffectiveCurrency())
+
+	require.Equal(t, "CAD", cad.Accounts[0].EffectiveCurrency(cad))
+	require.Equal(t, "CAD", cad.Accounts[0].EffectiveCurrency(nil))
+	require.Equal(t, "USD", cad.Accounts[1].EffectiveCurrency(cad))
+	require.Equal(t, "USD", usd.Accounts[0].EffectiveCurrency(usd))
+
+	require.Equal(t, "CAD", cad.Accounts[0].Details[0].Currency())
+	require.Equal(t, "USD", cad.Accounts[1].Details[0].Currency())
+
+	totals, err := file.ControlTotalsByCurrency()
+	require.NoError(t, err)
+	require.Equal(t, map[string]Money{
+		"CAD": NewMoney(190000, "CAD"),
+		"USD": NewMoney(2000, "USD"),
+	}, totals)
+
+	totals, err = cad.ControlTotalsByCurrency()
+	require.NoError(t, err)
+	require.Equal(t, NewMoney(-500, "USD"), totals["USD"])
+
+	// currencies need to be resolved again after changes
+	cad.CurrencyCode = "EUR"
+	require.Equal(t, "CAD", cad.Accounts[0].Details[0].Currency())
+	file.ResolveCurrencies()
+	require.Equal(t, "EUR", cad.Accounts[0].Details[0].Currency())
+	require.Equal(t, "EUR", cad.Accounts[0].EffectiveCurrency(nil))
+
+	cad.Accounts[0].AccountControlTotal = "1.00"
+	_, err = file.ControlTotalsByCurrency()
+	require.EqualError(t, err, `invalid amount "1.00" for account 10200123456`)
+}
+
+func TestDecoderEffectiveCurrency(t *testing.T) {
+	scan := NewBai2Scanner(strings.NewReader(multiCurrencySample))
+	decoder := NewDecoder(&scan)
+
+	var currencies []string
+	for {
+		event, err := decoder.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		if event.Type == DetailEvent {
+			currencies = append(currencies, event.Detail.Currency())
+		}
+	}
+	require.Equal(t, []string{"CAD", "USD"}, currencies)
+}
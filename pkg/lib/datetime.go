@@ -0,0 +1,167 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/moov-io/bai2/pkg/util"
+)
+
+const (
+	dateFormat = "060102"
+	timeFormat = "1504"
+)
+
+// TimeZones holds the time zone of every sender and originator, identified by its routing number.
+// File creation times are expressed in the time zone of the sender, as-of and value times in the time
+// zone of the originator. It is used unless files are interpreted with another registry given by UseTimeZones.
+var TimeZones = NewTimeZoneRegistry()
+
+// TimeZoneRegistry maps senders and originators to their time zone. It is safe for concurrent use.
+type TimeZoneRegistry struct {
+	mu        sync.RWMutex
+	locations map[string]*time.Location
+	fallback  *time.Location
+}
+
+// NewTimeZoneRegistry returns a registry in which every party is in UTC until registered otherwise
+func NewTimeZoneRegistry() *TimeZoneRegistry {
+	return &TimeZoneRegistry{
+		locations: make(map[string]*time.Location),
+		fallback:  time.UTC,
+	}
+}
+
+// Register sets the time zone of a sender or originator
+func (r *TimeZoneRegistry) Register(id string, location *time.Location) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.locations[id] = location
+}
+
+// SetDefault sets the time zone of the parties that are not registered
+func (r *TimeZoneRegistry) SetDefault(location *time.Location) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.fallback = location
+}
+
+// Location returns the time zone of a sender or originator
+func (r *TimeZoneRegistry) Location(id string) *time.Location {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if location, ok := r.locations[id]; ok && location != nil {
+		return location
+	}
+	if r.fallback != nil {
+		return r.fallback
+	}
+	return time.UTC
+}
+
+// parseDateTime returns the time of a YYMMDD date and an optional HHMM time in the location.
+//
+// Two-digit years 69 through 99 are in the 1900s, 00 through 68 in the 2000s. The times 2400 and 9999
+// both indicate the end of the day, which is returned as midnight at the start of the following day.
+// A date without a time is returned at the start of the day.
+func parseDateTime(date, clock string, location *time.Location) (time.Time, error) {
+	if !util.ValidateDate(date) {
+		return time.Time{}, fmt.Errorf("invalid date %q", date)
+	}
+	day, err := time.ParseInLocation(dateFormat, date, location)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q", date)
+	}
+
+	switch {
+	case clock == "":
+		return day, nil
+	case clock == "2400" || clock == "9999":
+		return day.AddDate(0, 0, 1), nil
+	case !util.ValidateTime(clock):
+		return time.Time{}, fmt.Errorf("invalid time %q", clock)
+	}
+
+	t, err := time.ParseInLocation(dateFormat+timeFormat, date+clock, location)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q", clock)
+	}
+	return t, nil
+}
+
+// formatDateTime returns the YYMMDD date and HHMM time of t in the location
+func formatDateTime(t time.Time, location *time.Location) (string, string) {
+	t = t.In(location)
+	return t.Format(dateFormat), t.Format(timeFormat)
+}
+
+// FileCreated returns the file creation date and time, in the time zone of the sender
+func (r *Bai2) FileCreated() (time.Time, error) {
+	t, err := parseDateTime(r.FileCreatedDate, r.FileCreatedTime, r.registries.timeZoneRegistry().Location(r.Sender))
+	if err != nil {
+		return t, fmt.Errorf("FileHeader: %v", err)
+	}
+	return t, nil
+}
+
+// SetFileCreated sets the file creation date and time, converted to the time zone of the sender
+func (r *Bai2) SetFileCreated(t time.Time) {
+	r.FileCreatedDate, r.FileCreatedTime = formatDateTime(t, r.registries.timeZoneRegistry().Location(r.Sender))
+}
+
+// Location returns the time zone of the originator, in which the dates and times of the group are expressed
+func (r *Group) Location() *time.Location {
+	return r.registries.timeZoneRegistry().Location(r.Originator)
+}
+
+// AsOf returns the date and time for which the information of the group is current, in the time zone
+// of the originator. A group without an as-of time is current as of the start of its as-of date.
+func (r *Group) AsOf() (time.Time, error) {
+	t, err := parseDateTime(r.AsOfDate, r.AsOfTime, r.Location())
+	if err != nil {
+		return t, fmt.Errorf("GroupHeader: %v", err)
+	}
+	return t, nil
+}
+
+// AsOfDay returns the start of the as-of date in the time zone of the originator. Unlike AsOf, an end of day
+// as-of time (2400 or 9999) does not move it to the following day, so it dates statements and balances.
+func (r *Group) AsOfDay() (time.Time, error) {
+	t, err := parseDateTime(r.AsOfDate, "", r.Location())
+	if err != nil {
+		return t, fmt.Errorf("GroupHeader: %v", err)
+	}
+	return t, nil
+}
+
+// SetAsOf sets the as-of date and time, converted to the time zone of the originator
+func (r *Group) SetAsOf(t time.Time) {
+	r.AsOfDate, r.AsOfTime = formatDateTime(t, r.Location())
+}
+
+// ValueDate returns the value date and time of a value dated (V) funds type, in the time zone of the
+// originator, which is given by Group.Location.
+func (f *FundsType) ValueDate(location *time.Location) (time.Time, error) {
+	if !strings.EqualFold(string(f.TypeCode), FundsTypeV) {
+		return time.Time{}, fmt.Errorf("funds type %s is not value dated", f.TypeCode)
+	}
+	if location == nil {
+		location = time.UTC
+	}
+	return parseDateTime(f.Date, f.Time, location)
+}
+
+// SetValueDate sets a value dated (V) funds type with the date and time of t in its location
+func (f *FundsType) SetValueDate(t time.Time) {
+	f.TypeCode = FundsTypeV
+	f.Date, f.Time = formatDateTime(t, t.Location())
+}
@@ -0,0 +1,126 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDateTime(t *testing.T) {
+	testCases := []struct {
+		date     string
+		clock    string
+		expected time.Time
+	}{
+		{"060321", "0829", time.Date(2006, time.March, 21, 8, 29, 0, 0, time.UTC)},
+		{"991231", "", time.Date(1999, time.December, 31, 0, 0, 0, 0, time.UTC)},
+		{"690101", "0000", time.Date(1969, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{"680101", "2359", time.Date(2068, time.January, 1, 23, 59, 0, 0, time.UTC)},
+		{"220919", "2400", time.Date(2022, time.September, 20, 0, 0, 0, 0, time.UTC)},
+		{"221231", "9999", time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range testCases {
+		actual, err := parseDateTime(tc.date, tc.clock, time.UTC)
+		require.NoError(t, err, tc.date+tc.clock)
+		require.Equal(t, tc.expected, actual, tc.date+tc.clock)
+	}
+
+	_, err := parseDateTime("220231", "", time.UTC)
+	require.EqualError(t, err, `invalid date "220231"`)
+
+	_, err = parseDateTime("2209190", "", time.UTC)
+	require.EqualError(t, err, `invalid date "2209190"`)
+
+	_, err = parseDateTime("220919", "2561", time.UTC)
+	require.EqualError(t, err, `invalid time "2561"`)
+}
+
+func TestFileCreated(t *testing.T) {
+	chicago := time.FixedZone("CST", -6*60*60)
+
+	zones := NewTimeZoneRegistry()
+	zones.Register("0004", chicago)
+
+	file := Bai2{Sender: "0004", FileCreatedDate: "060321", FileCreatedTime: "0829"}
+	file.UseRegistries(UseTimeZones(zones))
+	created, err := file.FileCreated()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2006, time.March, 21, 14, 29, 0, 0, time.UTC), created.UTC())
+
+	file.SetFileCreated(time.Date(2006, time.March, 22, 3, 0, 0, 0, time.UTC))
+	require.Equal(t, "060321", file.FileCreatedDate)
+	require.Equal(t, "2100", file.FileCreatedTime)
+
+	// unregistered senders are in the default time zone
+	file.Sender = "12345"
+	created, err = file.FileCreated()
+	require.NoError(t, err)
+	require.Equal(t, time.UTC, created.Location())
+
+	zones.SetDefault(chicago)
+	created, err = file.FileCreated()
+	require.NoError(t, err)
+	require.Equal(t, chicago, created.Location())
+
+	file.FileCreatedTime = "2561"
+	_, err = file.FileCreated()
+	require.EqualError(t, err, `FileHeader: invalid time "2561"`)
+}
+
+func TestGroupAsOf(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+
+	zones := NewTimeZoneRegistry()
+	zones.Register("121000358", tokyo)
+
+	group := Group{Originator: "121000358", AsOfDate: "220919", AsOfTime: "9999"}
+	asOf, err := group.AsOf()
+	require.NoError(t, err)
+	require.Equal(t, time.UTC, asOf.Location())
+
+	group.UseRegistries(UseTimeZones(zones))
+	asOf, err = group.AsOf()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2022, time.September, 20, 0, 0, 0, 0, tokyo), asOf)
+
+	day, err := group.AsOfDay()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2022, time.September, 19, 0, 0, 0, 0, tokyo), day)
+
+	group.SetAsOf(time.Date(2022, time.September, 19, 15, 30, 0, 0, time.UTC))
+	require.Equal(t, "220920", group.AsOfDate)
+	require.Equal(t, "0030", group.AsOfTime)
+
+	group.AsOfTime = ""
+	asOf, err = group.AsOf()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2022, time.September, 20, 0, 0, 0, 0, tokyo), asOf)
+
+	group.AsOfDate = ""
+	_, err = group.AsOf()
+	require.EqualError(t, err, `GroupHeader: invalid date ""`)
+	_, err = group.AsOfDay()
+	require.EqualError(t, err, `GroupHeader: invalid date ""`)
+}
+
+func TestFundsTypeValueDate(t *testing.T) {
+	funds := FundsType{TypeCode: FundsTypeV, Date: "060316", Time: "1300"}
+	valueDate, err := funds.ValueDate(nil)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2006, time.March, 16, 13, 0, 0, 0, time.UTC), valueDate)
+
+	funds = FundsType{}
+	funds.SetValueDate(time.Date(2006, time.March, 17, 0, 0, 0, 0, time.UTC))
+	require.Equal(t, FundsType{TypeCode: FundsTypeV, Date: "060317", Time: "0000"}, funds)
+	require.Equal(t, "V,060317,0000", funds.String())
+
+	funds = FundsType{TypeCode: FundsTypeS}
+	_, err = funds.ValueDate(time.UTC)
+	require.EqualError(t, err, "funds type S is not value dated")
+}
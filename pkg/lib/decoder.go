@@ -0,0 +1,316 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/moov-io/bai2/pkg/util"
+)
+
+// EventType identifies the record that produced an Event
+type EventType int
+
+const (
+	FileHeaderEvent EventType = iota + 1
+	GroupHeaderEvent
+	AccountIdentifierEvent
+	DetailEvent
+	AccountTrailerEvent
+	GroupTrailerEvent
+	FileTrailerEvent
+)
+
+var eventTypeNames = map[EventType]string{
+	FileHeaderEvent:        "FileHeader",
+	GroupHeaderEvent:       "GroupHeader",
+	AccountIdentifierEvent: "AccountIdentifier",
+	DetailEvent:            "Detail",
+	AccountTrailerEvent:    "AccountTrailer",
+	GroupTrailerEvent:      "GroupTrailer",
+	FileTrailerEvent:       "FileTrailer",
+}
+
+func (t EventType) String() string {
+	if name, ok := eventTypeNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("EventType(%d)", t)
+}
+
+// Event is a single logical record returned by a Decoder.
+//
+// Envelopes are returned without their children: Bai2.Groups, Group.Accounts and Account.Details are always empty.
+// Trailer events carry the header fields of the envelope they close along with the trailer fields.
+type Event struct {
+	Type EventType
+	// Line is the index of the physical record that started the logical record
+	Line int
+
+	// File is set for FileHeaderEvent and FileTrailerEvent
+	File *Bai2
+	// Group is set for GroupHeaderEvent and GroupTrailerEvent
+	Group *Group
+	// Account is set for AccountIdentifierEvent and AccountTrailerEvent
+	Account *Account
+	// Detail is set for DetailEvent
+	Detail *Detail
+}
+
+const (
+	decoderStart = iota
+	decoderFile
+	decoderGroup
+	decoderAccount
+	decoderDone
+)
+
+// Decoder reads a BAI2 file one record at a time, without holding previously read records in memory.
+// The nesting of the file, group and account envelopes is enforced while reading.
+//
+// Unlike Bai2.Read, a Decoder always stops at the first error, even when the scanner was configured with
+// ContinueOnError.
+type Decoder struct {
+	scan           *Bai2Scanner
+	useCurrentLine bool
+	state          int
+	err            error
+
+	file    Bai2
+	group   Group
+	account Account
+}
+
+func NewDecoder(scan *Bai2Scanner) *Decoder {
+	return &Decoder{scan: scan}
+}
+
+// Next returns the next record of the file. io.EOF is returned once the file trailer has been read, or when the
+// input ends cleanly before the file header. Any other error is returned for every subsequent call.
+func (d *Decoder) Next() (*Event, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	event, err := d.next()
+	if err != nil {
+		d.err = err
+		return nil, err
+	}
+
+	return event, nil
+}
+
+func (d *Decoder) next() (*Event, error) {
+	if d.scan == nil {
+		return nil, errors.New("invalid bai2 scanner")
+	}
+
+	for line := d.scan.ScanLine(d.useCurrentLine); line != ""; line = d.scan.ScanLine(d.useCurrentLine) {
+		d.useCurrentLine = false
+
+		// find record code
+		if len(line) < 3 {
+			continue
+		}
+
+		if d.state == decoderDone {
+			return nil, d.unexpectedRecord(line)
+		}
+
+		switch line[:2] {
+		case util.FileHeaderCode:
+			if d.state != decoderStart {
+				return nil, d.unexpectedRecord(line)
+			}
+
+			rawLine := d.scan.GetLineIndex()
+			rawData := d.scan.readContinuations(line)
+
+			newRecord := fileHeader{}
+			if _, err := newRecord.parse(rawData); err != nil {
+				return nil, newParseError("file header", rawLine, rawData, err)
+			}
+
+			d.file = Bai2{
+				registries:           d.scan.registries,
+				Sender:               newRecord.Sender,
+				Receiver:             newRecord.Receiver,
+				FileCreatedDate:      newRecord.FileCreatedDate,
+				FileCreatedTime:      newRecord.FileCreatedTime,
+				FileIdNumber:         newRecord.FileIdNumber,
+				PhysicalRecordLength: newRecord.PhysicalRecordLength,
+				BlockSize:            newRecord.BlockSize,
+				VersionNumber:        newRecord.VersionNumber,
+			}
+			d.state = decoderFile
+
+			file := d.file
+			return &Event{Type: FileHeaderEvent, Line: rawLine, File: &file}, nil
+
+		case util.GroupHeaderCode:
+			if d.state != decoderFile {
+				return nil, d.unexpectedRecord(line)
+			}
+
+			rawLine := d.scan.GetLineIndex()
+			rawData := d.scan.readContinuations(line)
+
+			newRecord := groupHeader{}
+			if _, err := newRecord.parse(rawData); err != nil {
+				return nil, newParseError("group header", rawLine, rawData, err)
+			}
+
+			d.group = Group{
+				registries:       d.scan.registries,
+				Receiver:         newRecord.Receiver,
+				Originator:       newRecord.Originator,
+				GroupStatus:      newRecord.GroupStatus,
+				AsOfDate:         newRecord.AsOfDate,
+				AsOfTime:         newRecord.AsOfTime,
+				CurrencyCode:     newRecord.CurrencyCode,
+				AsOfDateModifier: newRecord.AsOfDateModifier,
+			}
+			d.state = decoderGroup
+
+			group := d.group
+			return &Event{Type: GroupHeaderEvent, Line: rawLine, Group: &group}, nil
+
+		case util.AccountIdentifierCode:
+			if d.state != decoderGroup {
+				return nil, d.unexpectedRecord(line)
+			}
+
+			rawLine := d.scan.GetLineIndex()
+			rawData := d.scan.readContinuations(line)
+
+			newRecord := accountIdentifier{}
+			if _, err := newRecord.parse(rawData); err != nil {
+				return nil, newParseError("account identifier", rawLine, rawData, err)
+			}
+
+			d.account = Account{
+				AccountNumber: newRecord.AccountNumber,
+				CurrencyCode:  newRecord.CurrencyCode,
+				Summaries:     newRecord.Summaries,
+				originator:    d.group.Originator,
+				groupCurrency: d.group.CurrencyCode,
+				registries:    d.scan.registries,
+			}
+			d.state = decoderAccount
+
+			account := d.account
+			return &Event{Type: AccountIdentifierEvent, Line: rawLine, Account: &account}, nil
+
+		case util.TransactionDetailCode:
+			if d.state != decoderAccount {
+				return nil, d.unexpectedRecord(line)
+			}
+
+			rawLine := d.scan.GetLineIndex()
+			detail := NewDetail()
+			if err := detail.Read(d.scan, true); err != nil {
+				return nil, err
+			}
+			detail.currency = d.account.EffectiveCurrency(nil)
+			d.useCurrentLine = true
+
+			return &Event{Type: DetailEvent, Line: rawLine, Detail: detail}, nil
+
+		case util.AccountTrailerCode:
+			if d.state != decoderAccount {
+				return nil, d.unexpectedRecord(line)
+			}
+
+			rawLine := d.scan.GetLineIndex()
+			rawData := d.scan.readContinuations(line)
+
+			newRecord := accountTrailer{}
+			if _, err := newRecord.parse(rawData); err != nil {
+				return nil, newParseError("account trailer", rawLine, rawData, err)
+			}
+
+			account := d.account
+			account.AccountControlTotal = newRecord.AccountControlTotal
+			account.NumberRecords = newRecord.NumberRecords
+			d.state = decoderGroup
+
+			return &Event{Type: AccountTrailerEvent, Line: rawLine, Account: &account}, nil
+
+		case util.GroupTrailerCode:
+			if d.state != decoderGroup {
+				return nil, d.unexpectedRecord(line)
+			}
+
+			rawLine := d.scan.GetLineIndex()
+			rawData := d.scan.readContinuations(line)
+
+			newRecord := groupTrailer{}
+			if _, err := newRecord.parse(rawData); err != nil {
+				return nil, newParseError("group trailer", rawLine, rawData, err)
+			}
+
+			group := d.group
+			group.GroupControlTotal = newRecord.GroupControlTotal
+			group.NumberOfAccounts = newRecord.NumberOfAccounts
+			group.NumberOfRecords = newRecord.NumberOfRecords
+			d.state = decoderFile
+
+			return &Event{Type: GroupTrailerEvent, Line: rawLine, Group: &group}, nil
+
+		case util.FileTrailerCode:
+			if d.state != decoderFile {
+				return nil, d.unexpectedRecord(line)
+			}
+
+			rawLine := d.scan.GetLineIndex()
+			rawData := d.scan.readContinuations(line)
+
+			newRecord := fileTrailer{}
+			if _, err := newRecord.parse(rawData); err != nil {
+				return nil, newParseError("file trailer", rawLine, rawData, err)
+			}
+
+			file := d.file
+			file.FileControlTotal = newRecord.FileControlTotal
+			file.NumberOfGroups = newRecord.NumberOfGroups
+			file.NumberOfRecords = newRecord.NumberOfRecords
+			d.state = decoderDone
+
+			return &Event{Type: FileTrailerEvent, Line: rawLine, File: &file}, nil
+
+		default:
+			return nil, d.unexpectedRecord(line)
+		}
+	}
+
+	if err := d.scan.Err(); err != nil {
+		return nil, err
+	}
+
+	if d.state != decoderStart && d.state != decoderDone {
+		return nil, newParseError(d.envelope(), d.scan.GetLineIndex(), "", errors.New("unexpected end of file"))
+	}
+
+	return nil, io.EOF
+}
+
+// envelope returns the name of the envelope the decoder is currently reading
+func (d *Decoder) envelope() string {
+	switch d.state {
+	case decoderGroup:
+		return "group"
+	case decoderAccount:
+		return "account"
+	}
+	return "file"
+}
+
+func (d *Decoder) unexpectedRecord(line string) error {
+	err := &fieldError{field: "RecordCode", offset: 0, err: fmt.Errorf("unexpected record type %s", line[0:2])}
+	return newParseError(d.envelope(), d.scan.GetLineIndex(), line, err)
+}
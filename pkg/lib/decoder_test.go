@@ -0,0 +1,273 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecoderWithSampleData(t *testing.T) {
+	paths := []string{
+		"sample1.txt",
+		"sample2.txt",
+		"sample4-continuations-newline-delimited.txt",
+		"sample5-issue113.txt",
+	}
+
+	for _, path := range paths {
+		samplePath := filepath.Join("..", "..", "test", "testdata", path)
+
+		fd, err := os.Open(samplePath)
+		require.NoError(t, err)
+		scan := NewBai2Scanner(fd)
+		file := NewBai2()
+		require.NoError(t, file.Read(&scan))
+		fd.Close()
+
+		fd, err = os.Open(samplePath)
+		require.NoError(t, err)
+		defer fd.Close()
+		scan = NewBai2Scanner(fd)
+		decoder := NewDecoder(&scan)
+
+		// Rebuild the file from the events and compare it with the result of Bai2.Read
+		decoded := NewBai2()
+		for {
+			event, err := decoder.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+
+			switch event.Type {
+			case FileHeaderEvent:
+				*decoded = *event.File
+			case GroupHeaderEvent:
+				decoded.Groups = append(decoded.Groups, *event.Group)
+			case AccountIdentifierEvent:
+				group := &decoded.Groups[len(decoded.Groups)-1]
+				group.Accounts = append(group.Accounts, *event.Account)
+			case DetailEvent:
+				group := &decoded.Groups[len(decoded.Groups)-1]
+				account := &group.Accounts[len(group.Accounts)-1]
+				account.Details = append(account.Details, *event.Detail)
+			case AccountTrailerEvent:
+				group := &decoded.Groups[len(decoded.Groups)-1]
+				account := &group.Accounts[len(group.Accounts)-1]
+				account.AccountControlTotal = event.Account.AccountControlTotal
+				account.NumberRecords = event.Account.NumberRecords
+			case GroupTrailerEvent:
+				group := &decoded.Groups[len(decoded.Groups)-1]
+				group.GroupControlTotal = event.Group.GroupControlTotal
+				group.NumberOfAccounts = event.Group.NumberOfAccounts
+				group.NumberOfRecords = event.Group.NumberOfRecords
+			case FileTrailerEvent:
+				decoded.FileControlTotal = event.File.FileControlTotal
+				decoded.NumberOfGroups = event.File.NumberOfGroups
+				decoded.NumberOfRecords = event.File.NumberOfRecords
+			}
+		}
+
+		require.Equal(t, file.String(), decoded.String())
+	}
+}
+
+func TestDecoderEvents(t *testing.T) {
+	raw := `01,0004,12345,060321,0829,001,80,1,2/
+02,12345,0004,1,060317,,CAD,/
+03,10200123456,CAD,040,+000000000000,,/
+88,045,+000000000000,,/
+16,409,000000000002500,V,060316,1300,,,RETURNED CHEQUE/
+88,SECOND LINE/
+49,+00000000000834000,4/
+98,+00000000001280000,1,6/
+99,+00000000001280000,1,8/`
+
+	scan := NewBai2Scanner(strings.NewReader(raw))
+	decoder := NewDecoder(&scan)
+
+	expected := []struct {
+		eventType EventType
+		line      int
+	}{
+		{FileHeaderEvent, 1},
+		{GroupHeaderEvent, 2},
+		{AccountIdentifierEvent, 3},
+		{DetailEvent, 5},
+		{AccountTrailerEvent, 7},
+		{GroupTrailerEvent, 8},
+		{FileTrailerEvent, 9},
+	}
+
+	var events []*Event
+	for _, want := range expected {
+		event, err := decoder.Next()
+		require.NoError(t, err)
+		require.Equal(t, want.eventType, event.Type, want.eventType.String())
+		require.Equal(t, want.line, event.Line)
+		events = append(events, event)
+	}
+
+	_, err := decoder.Next()
+	require.Equal(t, io.EOF, err)
+
+	require.Len(t, events[2].Account.Summaries, 2)
+	require.Equal(t, "RETURNED CHEQUE,SECOND LINE/", events[3].Detail.Text)
+	require.Equal(t, "10200123456", events[4].Account.AccountNumber)
+	require.Equal(t, int64(4), events[4].Account.NumberRecords)
+	require.Equal(t, "0004", events[5].Group.Originator)
+	require.Equal(t, "+00000000001280000", events[5].Group.GroupControlTotal)
+	require.Equal(t, "0004", events[6].File.Sender)
+	require.Equal(t, int64(8), events[6].File.NumberOfRecords)
+}
+
+func TestDecoderContinuedHeadersAndTrailers(t *testing.T) {
+	raw := `01,0004,12345,060321,0829/
+88,001,80,1,2/
+02,12345,0004,1/
+88,060317,,CAD,/
+03,10200123456,CAD,040,+000000000000,,/
+49,+00000000000000000/
+88,2/
+98,+00000000000000000,1/
+88,6/
+99,+00000000000000000/
+88,1,10/`
+
+	scan := NewBai2Scanner(strings.NewReader(raw))
+	decoder := NewDecoder(&scan)
+
+	var events []*Event
+	for {
+		event, err := decoder.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		events = append(events, event)
+	}
+
+	require.Len(t, events, 6)
+	require.Equal(t, int64(2), events[0].File.VersionNumber)
+	require.Equal(t, "CAD", events[1].Group.CurrencyCode)
+	require.Equal(t, 3, events[1].Line)
+	require.Equal(t, int64(2), events[3].Account.NumberRecords)
+	require.Equal(t, 6, events[3].Line)
+	require.Equal(t, int64(6), events[4].Group.NumberOfRecords)
+	require.Equal(t, int64(10), events[5].File.NumberOfRecords)
+	require.Equal(t, 10, events[5].Line)
+}
+
+func TestDecoderFixedLengthAccountContinuation(t *testing.T) {
+	records := []string{
+		"01,0004,12345,060321,0829,001,50,2,2/",
+		"02,12345,0004,1,060317,,CAD,/",
+		"03,10200123456,CAD,040,+000000000000,,/",
+		"88,045,+000000000002500,,/",
+		"49,+00000000000002500,3/",
+		"98,+00000000000002500,1,5/",
+		"99,+00000000000002500,1,7/",
+	}
+	data := padRecords(records, 50, "")
+
+	scan := NewBai2Scanner(strings.NewReader(data), FixedLengthRecords(0))
+	decoder := NewDecoder(&scan)
+
+	var accounts []*Account
+	for {
+		event, err := decoder.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		if event.Type == AccountIdentifierEvent || event.Type == AccountTrailerEvent {
+			accounts = append(accounts, event.Account)
+		}
+	}
+
+	// The decoder reads the account the same way as Bai2.Read
+	scan = NewBai2Scanner(strings.NewReader(data), FixedLengthRecords(0))
+	file := NewBai2()
+	require.NoError(t, file.Read(&scan))
+	expected := file.Groups[0].Accounts[0]
+
+	require.Len(t, accounts, 2)
+	require.Len(t, accounts[0].Summaries, 2)
+	require.Equal(t, expected.Summaries, accounts[0].Summaries)
+	require.Equal(t, expected.NumberRecords, accounts[1].NumberRecords)
+}
+
+func TestDecoderNesting(t *testing.T) {
+	header := "01,0004,12345,060321,0829,001,80,1,2/\n"
+	group := "02,12345,0004,1,060317,,CAD,/\n"
+	account := "03,10200123456,CAD,040,+000000000000,,/\n"
+
+	samples := []struct {
+		raw     string
+		message string
+	}{
+		{
+			raw:     group,
+			message: "ERROR parsing file on line 1 (unexpected record type 02)",
+		},
+		{
+			raw:     header + account,
+			message: "ERROR parsing file on line 2 (unexpected record type 03)",
+		},
+		{
+			raw:     header + group + "16,409,000000000002500,,,,RETURNED CHEQUE/\n",
+			message: "ERROR parsing group on line 3 (unexpected record type 16)",
+		},
+		{
+			raw:     header + group + account + "98,+00000000001280000,1,6/\n",
+			message: "ERROR parsing account on line 4 (unexpected record type 98)",
+		},
+		{
+			raw:     header + group + account,
+			message: "ERROR parsing account on line 4 (unexpected end of file)",
+		},
+		{
+			raw:     header + "99,+00000000001280000,0,2/\n99,+00000000001280000,0,2/\n",
+			message: "ERROR parsing file on line 3 (unexpected record type 99)",
+		},
+	}
+
+	for _, sample := range samples {
+		scan := NewBai2Scanner(strings.NewReader(sample.raw))
+		decoder := NewDecoder(&scan)
+
+		var err error
+		for err == nil {
+			_, err = decoder.Next()
+		}
+		require.Equal(t, sample.message, err.Error())
+
+		var pErr *ParseError
+		require.ErrorAs(t, err, &pErr, sample.message)
+
+		// The error is returned again on subsequent calls
+		_, again := decoder.Next()
+		require.Equal(t, err, again)
+	}
+}
+
+func TestDecoder_ReadError(t *testing.T) {
+	scan := NewBai2Scanner(failingReader("01,0004,12345,060321,0829,001,80,1,2/\n"))
+	decoder := NewDecoder(&scan)
+
+	event, err := decoder.Next()
+	require.NoError(t, err)
+	require.Equal(t, FileHeaderEvent, event.Type)
+
+	_, err = decoder.Next()
+	require.True(t, errors.Is(err, errTestRead))
+}
@@ -39,6 +39,7 @@ func (r *Detail) Read(scan *Bai2Scanner, useCurrentLine bool) error {
 	}
 
 	var rawData string
+	var rawLine int
 	find := false
 	isBreak := false
 
@@ -59,10 +60,11 @@ func (r *Detail) Read(scan *Bai2Scanner, useCurrentLine bool) error {
 			}
 
 			rawData = line
+			rawLine = scan.GetLineIndex()
 			find = true
 
 		case util.ContinuationCode:
-			rawData = rawData[:len(rawData)-1] + "," + line[3:]
+			rawData = joinContinuation(rawData, line)
 
 		default:
 			isBreak = true
@@ -73,6 +75,13 @@ func (r *Detail) Read(scan *Bai2Scanner, useCurrentLine bool) error {
 		}
 	}
 
-	_, err := (*transactionDetail)(r).parse(rawData)
-	return err
+	if err := scan.Err(); err != nil {
+		return err
+	}
+
+	if _, err := (*transactionDetail)(r).parse(rawData); err != nil {
+		return newParseError("transaction detail", rawLine, rawData, err)
+	}
+
+	return nil
 }
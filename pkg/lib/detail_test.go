@@ -75,3 +75,15 @@ func TestDetail_ContinuationRecordWithNewlineDelimiter(t *testing.T) {
 88,CRNM: ABC Company,DBNM: SAMPLE INC./`
 	require.Equal(t, expectResult, detail.String(50))
 }
+
+func TestDetailRead_ReadError(t *testing.T) {
+	raw := `16,115,10000000,S,5000000,4000000,1000000/
+88,AX13612,B096132,AMALGAMATED CORP. LOCKBOX/
+`
+
+	scan := NewBai2Scanner(failingReader(raw))
+	detail := NewDetail()
+
+	err := detail.Read(&scan, false)
+	require.ErrorIs(t, err, errTestRead)
+}
@@ -0,0 +1,112 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+
+	"github.com/moov-io/bai2/pkg/util"
+)
+
+/*
+
+CHARACTER SETS
+
+Files from mainframes are often encoded in EBCDIC, and files from older systems in Windows-1252. Files are read
+and written in UTF-8 unless an encoding is configured, such as charmap.CodePage037, charmap.CodePage1047 or
+charmap.Windows1252 of golang.org/x/text/encoding/charmap.
+
+The EBCDIC next line control character is read as a line feed.
+
+*/
+
+// encodingPeekSize is the number of bytes inspected to detect the encoding of a file
+const encodingPeekSize = 4096
+
+var (
+	// ebcdicFileHeader is the record code of the file header and its delimiter in EBCDIC
+	ebcdicFileHeader = []byte{0xF0, 0xF1, 0x6B}
+	// leadingSpace holds the white space characters that may precede the file header, in ASCII and EBCDIC
+	leadingSpace = []byte{' ', '\t', '\r', '\n', 0x40, 0x25, 0x15}
+)
+
+// InputEncoding configures the scanner to read files in the given character set
+func InputEncoding(enc encoding.Encoding) ScannerOption {
+	return func(b *Bai2Scanner) {
+		b.encoding = enc
+	}
+}
+
+// DetectInputEncoding configures the scanner to detect the character set of the file from its first bytes. Files
+// starting with an EBCDIC file header are read as CodePage037, which shares the characters used by BAI2 records
+// with CodePage1047. Files that are not valid UTF-8 are read as Windows-1252.
+func DetectInputEncoding() ScannerOption {
+	return func(b *Bai2Scanner) {
+		b.detectEncoding = true
+	}
+}
+
+// OutputEncoding configures the writer to write files in the given character set. Characters that the character
+// set can not represent are reported as write errors.
+func OutputEncoding(enc encoding.Encoding) WriterOption {
+	return func(w *Writer) {
+		w.encoding = enc
+	}
+}
+
+func newDecodingReader(src io.Reader, enc encoding.Encoding, detect bool) io.Reader {
+	reader := bufio.NewReader(src)
+	if enc == nil && detect {
+		enc = detectEncoding(reader)
+	}
+	if enc == nil {
+		return reader
+	}
+
+	nextLine := runes.Map(func(r rune) rune {
+		if r == '\u0085' {
+			return '\n'
+		}
+		return r
+	})
+	return transform.NewReader(reader, transform.Chain(enc.NewDecoder(), nextLine))
+}
+
+// detectEncoding returns the character set of the file, or nil for UTF-8
+func detectEncoding(reader *bufio.Reader) encoding.Encoding {
+	data, _ := reader.Peek(encodingPeekSize)
+
+	for len(data) > 0 && bytes.IndexByte(leadingSpace, data[0]) >= 0 {
+		data = data[1:]
+	}
+
+	if bytes.HasPrefix(data, ebcdicFileHeader) {
+		return charmap.CodePage037
+	}
+	if !bytes.HasPrefix(data, []byte(util.FileHeaderCode+",")) {
+		return nil
+	}
+
+	// the peeked data may end within a character
+	for len(data) > 0 {
+		r, size := utf8.DecodeRune(data)
+		if r == utf8.RuneError && size <= 1 {
+			if !utf8.FullRune(data) {
+				return nil
+			}
+			return charmap.Windows1252
+		}
+		data = data[size:]
+	}
+	return nil
+}
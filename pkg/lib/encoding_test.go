@@ -0,0 +1,94 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+)
+
+const accentedSample = `01,0004,12345,060321,0829,001,80,1,2/
+02,12345,0004,1,060317,,EUR,/
+03,10200123456,,010,100000,,/
+16,115,2500,,,,DÉPÔT CAFÉ/
+49,102500,3/
+98,102500,1,5/
+99,102500,1,7/`
+
+func encodeSample(t *testing.T, enc encoding.Encoding, sample string) []byte {
+	t.Helper()
+
+	data, err := enc.NewEncoder().Bytes([]byte(sample))
+	require.NoError(t, err)
+	return data
+}
+
+func TestInputEncoding(t *testing.T) {
+	testCases := []struct {
+		name string
+		data []byte
+		opt  ScannerOption
+	}{
+		{"utf-8", []byte(accentedSample), DetectInputEncoding()},
+		{"cp037", encodeSample(t, charmap.CodePage037, accentedSample), InputEncoding(charmap.CodePage037)},
+		{"cp1047", encodeSample(t, charmap.CodePage1047, accentedSample), InputEncoding(charmap.CodePage1047)},
+		{"detected cp037", encodeSample(t, charmap.CodePage037, accentedSample), DetectInputEncoding()},
+		{"detected windows-1252", encodeSample(t, charmap.Windows1252, accentedSample), DetectInputEncoding()},
+	}
+
+	for _, tc := range testCases {
+		scan := NewBai2Scanner(bytes.NewReader(tc.data), tc.opt)
+		file := NewBai2()
+		require.NoError(t, file.Read(&scan), tc.name)
+		require.NoError(t, file.ValidateIntegrity(), tc.name)
+		require.Equal(t, accentedSample, file.String(), tc.name)
+	}
+}
+
+func TestInputEncodingWithFixedLengthRecords(t *testing.T) {
+	var records []string
+	for _, record := range strings.Split(accentedSample, "\n") {
+		records = append(records, strings.Replace(record, ",80,", ",40,", 1))
+	}
+
+	// EBCDIC records without line terminators, in which accented characters take a single byte
+	data := encodeSample(t, charmap.CodePage1047, padRecords(records, 40, ""))
+	require.Len(t, data, 40*len(records))
+
+	scan := NewBai2Scanner(bytes.NewReader(data), InputEncoding(charmap.CodePage1047), FixedLengthRecords(0))
+	file := NewBai2()
+	require.NoError(t, file.Read(&scan))
+	require.Equal(t, int64(40), file.PhysicalRecordLength)
+	require.Equal(t, "DÉPÔT CAFÉ/", file.Groups[0].Accounts[0].Details[0].Text)
+
+	// the EBCDIC next line character separates records as well
+	data = bytes.ReplaceAll(encodeSample(t, charmap.CodePage037, accentedSample), []byte{0x25}, []byte{0x15})
+	scan = NewBai2Scanner(bytes.NewReader(data), DetectInputEncoding())
+	file = NewBai2()
+	require.NoError(t, file.Read(&scan))
+	require.Equal(t, accentedSample, file.String())
+}
+
+func TestOutputEncoding(t *testing.T) {
+	scan := NewBai2Scanner(strings.NewReader(accentedSample))
+	file := NewBai2()
+	require.NoError(t, file.Read(&scan))
+
+	var buf bytes.Buffer
+	writer := NewWriter(&buf, OutputEncoding(charmap.CodePage1047))
+	require.NoError(t, writer.Write(file))
+	require.NoError(t, writer.Close())
+	require.Equal(t, encodeSample(t, charmap.CodePage1047, accentedSample), buf.Bytes())
+
+	file.Groups[0].Accounts[0].Details[0].Text = "DÉPÔT €"
+	writer = NewWriter(&bytes.Buffer{}, OutputEncoding(charmap.CodePage037))
+	require.NoError(t, writer.Write(file))
+	require.Error(t, writer.Flush())
+}
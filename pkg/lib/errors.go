@@ -0,0 +1,214 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/moov-io/bai2/pkg/util"
+)
+
+// ParseError is returned from the Read functions when a record can not be parsed.
+// Callers can retrieve it with errors.As to locate the failure within the source file.
+type ParseError struct {
+	// Line is the index of the physical record reported by the scanner
+	Line int
+	// Offset is the byte offset of the failing field within Raw, or -1 if the failure can not be positioned
+	Offset int
+	// Record is the record code of the failing record (e.g. "16")
+	Record string
+	// Field is the name of the failing field, or "record" if the record as a whole could not be parsed
+	Field string
+	// Raw is the text of the logical record, including the contents of any continuation records
+	Raw string
+	// Err is the underlying cause
+	Err error
+
+	name string
+}
+
+func (e *ParseError) Error() string {
+	name := e.name
+	if name == "" {
+		name = recordNames[e.Record]
+	}
+	if name == "" {
+		name = "record"
+	}
+	return fmt.Sprintf("ERROR parsing %s on line %d (%v)", name, e.Line, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+var recordNames = map[string]string{
+	util.FileHeaderCode:        "file header",
+	util.GroupHeaderCode:       "group header",
+	util.AccountIdentifierCode: "account identifier",
+	util.TransactionDetailCode: "transaction detail",
+	util.ContinuationCode:      "continuation",
+	util.AccountTrailerCode:    "account trailer",
+	util.GroupTrailerCode:      "group trailer",
+	util.FileTrailerCode:       "file trailer",
+}
+
+// recordFields maps the fixed position fields of every record to their index in the record,
+// the record code being index 0. It is used to position errors raised by record validation.
+var recordFields = map[string]map[string]int{
+	util.FileHeaderCode: {
+		"Sender": 1, "Receiver": 2, "FileCreatedDate": 3, "FileCreatedTime": 4,
+		"FileIdNumber": 5, "PhysicalRecordLength": 6, "BlockSize": 7, "VersionNumber": 8,
+	},
+	util.GroupHeaderCode: {
+		"Receiver": 1, "Originator": 2, "GroupStatus": 3, "AsOfDate": 4,
+		"AsOfTime": 5, "CurrencyCode": 6, "AsOfDateModifier": 7,
+	},
+	util.AccountIdentifierCode: {
+		"AccountNumber": 1, "CurrencyCode": 2,
+	},
+	util.TransactionDetailCode: {
+		"TypeCode": 1, "Amount": 2, "FundsType": 3,
+	},
+	util.AccountTrailerCode: {
+		"Amount": 1, "AccountControlTotal": 1, "NumberRecords": 2,
+	},
+	util.GroupTrailerCode: {
+		"GroupControlTotal": 1, "NumberOfAccounts": 2, "NumberOfRecords": 3,
+	},
+	util.FileTrailerCode: {
+		"FileControlTotal": 1, "NumberOfGroups": 2, "NumberOfRecords": 3,
+	},
+}
+
+// newParseError positions err, as returned by a record parser, at the current line of the scanner.
+func newParseError(name string, line int, raw string, err error) *ParseError {
+	pErr := &ParseError{
+		Line:   line,
+		Offset: -1,
+		Raw:    raw,
+		Err:    err,
+		name:   name,
+	}
+	if len(raw) >= 2 {
+		pErr.Record = raw[:2]
+	}
+
+	if fErr, ok := err.(*fieldError); ok {
+		pErr.Field = fErr.field
+		pErr.Offset = fErr.offset
+		pErr.Err = fErr.err
+		if pErr.Offset < 0 {
+			pErr.Offset = fieldOffset(raw, recordFields[pErr.Record], fErr.field)
+		}
+	}
+
+	return pErr
+}
+
+// fieldOffset returns the byte offset of the named field within a raw record, or -1 if unknown.
+func fieldOffset(raw string, fields map[string]int, field string) int {
+	index, ok := fields[field]
+	if !ok {
+		return -1
+	}
+
+	offset := 0
+	for i := 0; i < index; i++ {
+		next := strings.Index(raw[offset:], ",")
+		if next < 0 {
+			return -1
+		}
+		offset += next + 1
+	}
+
+	return offset
+}
+
+// fieldError is returned by the record parse and validate functions and carries the name
+// and byte offset of the field that failed.
+type fieldError struct {
+	field  string
+	offset int
+	err    error
+}
+
+func (e *fieldError) Error() string {
+	return e.err.Error()
+}
+
+func (e *fieldError) Unwrap() error {
+	return e.err
+}
+
+func newFieldError(format, field string, offset int) error {
+	return &fieldError{field: field, offset: offset, err: fmt.Errorf(format, field)}
+}
+
+func newValidationError(format, field string) error {
+	return newFieldError(format, field, -1)
+}
+
+// IntegrityError is returned by ValidateIntegrity when a trailer field does not match the contents of its envelope.
+type IntegrityError struct {
+	// Record is the record code of the trailer (e.g. "49")
+	Record string
+	// Field is the name of the trailer field
+	Field string
+	// Location identifies the envelope within the file (e.g. "group 1, account 10200123456")
+	Location string
+	// Expected is the value computed from the contents of the envelope
+	Expected string
+	// Actual is the value reported by the trailer
+	Actual string
+}
+
+func (e *IntegrityError) Error() string {
+	name := recordNames[e.Record]
+	if e.Location != "" {
+		name += " in " + e.Location
+	}
+	return fmt.Sprintf("ERROR validating %s (%s is %s, expected %s)", name, e.Field, e.Actual, e.Expected)
+}
+
+// within prefixes the location of every IntegrityError in errs with the location of the enclosing envelope
+func within(location string, errs []error) []error {
+	for _, err := range errs {
+		if iErr, ok := err.(*IntegrityError); ok {
+			if iErr.Location == "" {
+				iErr.Location = location
+			} else {
+				iErr.Location = location + ", " + iErr.Location
+			}
+		}
+	}
+	return errs
+}
+
+// parseControlTotal parses an amount or control total field, an empty field being zero
+func parseControlTotal(value string) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(value, 10, 64)
+}
+
+// ErrorList is a collection of errors returned when more than one problem is found,
+// for example when a file is read by a scanner configured with ContinueOnError.
+type ErrorList []error
+
+func (e ErrorList) Error() string {
+	messages := make([]string, len(e))
+	for i := range e {
+		messages[i] = e[i].Error()
+	}
+	return strings.Join(messages, "\n")
+}
+
+func (e ErrorList) Unwrap() []error {
+	return e
+}
@@ -0,0 +1,126 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseError(t *testing.T) {
+	header := "01,0004,12345,060321,0829,001,80,1,2/\n"
+	group := "02,12345,0004,1,060317,,CAD,/\n"
+	account := "03,10200123456,CAD,040,+000000000000,,,045,+000000000000,,/\n"
+
+	samples := []struct {
+		name    string
+		raw     string
+		line    int
+		record  string
+		field   string
+		offset  int
+		message string
+	}{
+		{
+			name:    "invalid file header date",
+			raw:     "01,0004,12345,061321,0829,001,80,1,2/\n",
+			line:    1,
+			record:  "01",
+			field:   "FileCreatedDate",
+			offset:  14,
+			message: "ERROR parsing file header on line 1 (FileHeader: invalid FileCreatedDate)",
+		},
+		{
+			name:    "unsupported record type",
+			raw:     header + "00,12345/\n",
+			line:    2,
+			record:  "00",
+			field:   "RecordCode",
+			offset:  0,
+			message: "ERROR parsing file on line 2 (unsupported record type 00)",
+		},
+		{
+			name:    "invalid group header originator",
+			raw:     header + "02,12345,,1,060317,,CAD,/\n",
+			line:    2,
+			record:  "02",
+			field:   "Originator",
+			offset:  9,
+			message: "ERROR parsing group header on line 2 (GroupHeader: invalid Originator)",
+		},
+		{
+			name:    "invalid account identifier currency",
+			raw:     header + group + "03,10200123456,CADX,040,+000000000000,,/\n16,409,000000000002500,,,,RETURNED CHEQUE/\n",
+			line:    3,
+			record:  "03",
+			field:   "CurrencyCode",
+			offset:  15,
+			message: "ERROR parsing account identifier on line 3 (AccountIdentifierCurrent: invalid CurrencyCode)",
+		},
+		{
+			name:    "invalid transaction detail amount",
+			raw:     header + group + account + "16,409,00000A000002500,,,,RETURNED CHEQUE/\n49,+00000000000834000,3/\n",
+			line:    4,
+			record:  "16",
+			field:   "Amount",
+			offset:  7,
+			message: "ERROR parsing transaction detail on line 4 (TransactionDetail: invalid Amount)",
+		},
+		{
+			name:    "invalid account trailer record count",
+			raw:     header + group + account + "49,+00000000000834000,X/\n",
+			line:    4,
+			record:  "49",
+			field:   "NumberRecords",
+			offset:  22,
+			message: "ERROR parsing account trailer on line 4 (AccountTrailer: unable to parse NumberRecords)",
+		},
+		{
+			name:    "invalid group trailer account count",
+			raw:     header + group + account + "49,+00000000000834000,2/\n98,+00000000001280000,Y,25/\n",
+			line:    5,
+			record:  "98",
+			field:   "NumberOfAccounts",
+			offset:  22,
+			message: "ERROR parsing group trailer on line 5 (GroupTrailer: unable to parse NumberOfAccounts)",
+		},
+	}
+
+	for _, sample := range samples {
+		t.Run(sample.name, func(t *testing.T) {
+			scan := NewBai2Scanner(strings.NewReader(sample.raw))
+			err := NewBai2().Read(&scan)
+			require.Error(t, err)
+			require.Equal(t, sample.message, err.Error())
+
+			var pErr *ParseError
+			require.True(t, errors.As(err, &pErr))
+			require.Equal(t, sample.line, pErr.Line)
+			require.Equal(t, sample.record, pErr.Record)
+			require.Equal(t, sample.field, pErr.Field)
+			require.Equal(t, sample.offset, pErr.Offset)
+			require.True(t, strings.HasPrefix(pErr.Raw, sample.record+","))
+		})
+	}
+}
+
+func TestParseError_ContinuationOffset(t *testing.T) {
+	raw := `03,10200123456,CAD,040,+000000000000,,/
+88,045,+0000000X0000,,/
+49,+00000000000834000,3/`
+
+	scan := NewBai2Scanner(strings.NewReader(raw))
+	account := NewAccount()
+	err := account.Read(&scan, false)
+
+	var pErr *ParseError
+	require.True(t, errors.As(err, &pErr))
+	require.Equal(t, 1, pErr.Line)
+	require.Equal(t, "03,10200123456,CAD,040,+000000000000,,,045,+0000000X0000,,/", pErr.Raw)
+	require.Equal(t, "Amount", pErr.Field)
+}
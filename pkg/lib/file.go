@@ -86,6 +86,11 @@ type Bai2 struct {
 
 	header  fileHeader
 	trailer fileTrailer
+
+	// number of physical records the file was read from
+	parsedRecords int64
+	// registries interpreting the file
+	registries registries
 }
 
 func (r *Bai2) copyRecords() {
@@ -115,8 +120,19 @@ func (f *Bai2) SumRecords() int64 {
 	for _, group := range f.Groups {
 		sum += group.NumberOfRecords
 	}
-	// Add two for the group header and trailer records
-	return sum + 2
+	return f.envelopeRecords(sum)
+}
+
+// envelopeRecords returns the number of physical records of the file holding groups of the given number of records
+func (f *Bai2) envelopeRecords(groups int64) int64 {
+	f.copyRecords()
+
+	sum := countRecords(f.header.string(f.PhysicalRecordLength)) + groups
+	return withTrailer(sum, func(count int64) string {
+		trailer := f.trailer
+		trailer.NumberOfRecords = count
+		return trailer.string(f.PhysicalRecordLength)
+	})
 }
 
 // Sums the number of groups. Maps to the NumberOfGroups field.
@@ -140,21 +156,104 @@ func (a *Bai2) SumGroupControlTotals() (string, error) {
 	return fmt.Sprint(sum), nil
 }
 
+// ValidateIntegrity compares the file trailer and the trailers of every group and account with their contents,
+// and returns an ErrorList holding an IntegrityError for every mismatch. Record counts of a file that was read
+// are compared with the physical records that were read, otherwise with the records written by String.
+func (r *Bai2) ValidateIntegrity() error {
+	var errs []error
+
+	var total, records int64
+	totalErr := false
+	for i := range r.Groups {
+		group := &r.Groups[i]
+		errs = append(errs, within(fmt.Sprintf("group %d", i+1), group.integrityErrors(r.PhysicalRecordLength))...)
+
+		amt, err := parseControlTotal(group.GroupControlTotal)
+		if err != nil {
+			// already reported against the group trailer
+			totalErr = true
+		}
+		total += amt
+		records += group.recordCount(r.PhysicalRecordLength)
+	}
+
+	if expected := int64(len(r.Groups)); expected != r.NumberOfGroups {
+		errs = append(errs, &IntegrityError{
+			Record:   util.FileTrailerCode,
+			Field:    "NumberOfGroups",
+			Expected: fmt.Sprint(expected),
+			Actual:   fmt.Sprint(r.NumberOfGroups),
+		})
+	}
+
+	expected := r.envelopeRecords(records)
+	if r.parsedRecords > 0 {
+		expected = r.parsedRecords
+	}
+	if expected != r.NumberOfRecords {
+		errs = append(errs, &IntegrityError{
+			Record:   util.FileTrailerCode,
+			Field:    "NumberOfRecords",
+			Expected: fmt.Sprint(expected),
+			Actual:   fmt.Sprint(r.NumberOfRecords),
+		})
+	}
+
+	if actual, err := parseControlTotal(r.FileControlTotal); !totalErr && (err != nil || actual != total) {
+		errs = append(errs, &IntegrityError{
+			Record:   util.FileTrailerCode,
+			Field:    "FileControlTotal",
+			Expected: fmt.Sprint(total),
+			Actual:   r.FileControlTotal,
+		})
+	}
+
+	if len(errs) > 0 {
+		return ErrorList(errs)
+	}
+	return nil
+}
+
+// Finalize sets the trailer fields of the file and of all its groups and accounts from their contents,
+// splitting records into continuations according to PhysicalRecordLength.
+func (r *Bai2) Finalize() error {
+	var total int64
+	for i := range r.Groups {
+		if err := r.Groups[i].Finalize(r.PhysicalRecordLength); err != nil {
+			return err
+		}
+		amt, err := parseControlTotal(r.Groups[i].GroupControlTotal)
+		if err != nil {
+			return fmt.Errorf("ERROR finalizing file (invalid control total %q of group %d)", r.Groups[i].GroupControlTotal, i+1)
+		}
+		total += amt
+	}
+
+	r.FileControlTotal = fmt.Sprint(total)
+	r.NumberOfGroups = r.SumNumberOfGroups()
+	r.NumberOfRecords = r.SumRecords()
+	r.parsedRecords = 0
+
+	return nil
+}
+
 func (r *Bai2) String() string {
 
 	r.copyRecords()
 
 	var buf bytes.Buffer
-	buf.WriteString(r.header.string() + "\n")
+	buf.WriteString(r.header.string(r.PhysicalRecordLength) + "\n")
 	for i := range r.Groups {
 		buf.WriteString(r.Groups[i].String(r.PhysicalRecordLength) + "\n")
 	}
-	buf.WriteString(r.trailer.string())
+	buf.WriteString(r.trailer.string(r.PhysicalRecordLength))
 
 	return buf.String()
 }
 
-func (r *Bai2) Validate() error {
+// Validate checks the fields of all records of the file. The options set the registries used to interpret
+// the file instead of those it was read or built with.
+func (r *Bai2) Validate(opts ...RegistryOption) error {
 
 	r.copyRecords()
 
@@ -163,7 +262,7 @@ func (r *Bai2) Validate() error {
 	}
 
 	for i := range r.Groups {
-		if err := r.Groups[i].Validate(); err != nil {
+		if err := r.Groups[i].Validate(opts...); err != nil {
 			return err
 		}
 	}
@@ -181,8 +280,13 @@ func (r *Bai2) Read(scan *Bai2Scanner) error {
 		return errors.New("invalid bai2 scanner")
 	}
 
+	r.registries = scan.registries
+
 	var err error
-	for line := scan.ScanLine(); line != ""; line = scan.ScanLine() {
+	var headerLine int
+	useCurrentLine := false
+	for line := scan.ScanLine(useCurrentLine); line != ""; line = scan.ScanLine(useCurrentLine) {
+		useCurrentLine = false
 
 		// find record code
 		if len(line) < 3 {
@@ -192,10 +296,15 @@ func (r *Bai2) Read(scan *Bai2Scanner) error {
 		switch line[0:2] {
 		case util.FileHeaderCode:
 
+			headerLine = scan.GetLineIndex()
+			record := scan.readContinuations(line)
+
 			newRecord := fileHeader{}
-			_, err = newRecord.parse(line)
+			_, err = newRecord.parse(record)
 			if err != nil {
-				return fmt.Errorf("ERROR parsing file header on line %d (%v)", scan.GetLineIndex(), err)
+				if err = scan.collect(newParseError("file header", headerLine, record, err)); err != nil {
+					return err
+				}
 			}
 
 			r.Sender = newRecord.Sender
@@ -217,24 +326,53 @@ func (r *Bai2) Read(scan *Bai2Scanner) error {
 
 			r.Groups = append(r.Groups, *newGroup)
 
+			// A group that is missing its trailer ends on the first record of the next envelope,
+			// which still needs to be processed.
+			useCurrentLine = !endsEnvelope(scan.GetLine(), util.GroupTrailerCode)
+
 		case util.FileTrailerCode:
 
+			trailerLine := scan.GetLineIndex()
+			record := scan.readContinuations(line)
+
 			newRecord := fileTrailer{}
-			_, err = newRecord.parse(line)
+			_, err = newRecord.parse(record)
 			if err != nil {
-				return fmt.Errorf("ERROR parsing file trailer on line %d (%v)", scan.GetLineIndex(), err)
+				if err = scan.collect(newParseError("file trailer", trailerLine, record, err)); err != nil {
+					return err
+				}
 			}
 
 			r.FileControlTotal = newRecord.FileControlTotal
 			r.NumberOfGroups = newRecord.NumberOfGroups
 			r.NumberOfRecords = newRecord.NumberOfRecords
+			if headerLine > 0 {
+				r.parsedRecords = int64(scan.GetLineIndex() - headerLine + 1)
+			}
 
-			return nil
+			return r.readErrors(scan)
 
 		default:
-			return fmt.Errorf("ERROR parsing file on line %d (unsupported record type %s)", scan.GetLineIndex(), line[0:2])
+			err = &fieldError{field: "RecordCode", offset: 0, err: fmt.Errorf("unsupported record type %s", line[0:2])}
+			if err = scan.collect(newParseError("file", scan.GetLineIndex(), line, err)); err != nil {
+				return err
+			}
+
+			scan.skipContinuations()
+			useCurrentLine = true
 		}
 	}
 
+	return r.readErrors(scan)
+}
+
+// readErrors returns the error state of the scanner once reading has completed
+func (r *Bai2) readErrors(scan *Bai2Scanner) error {
+	if err := scan.Err(); err != nil {
+		return err
+	}
+	if errs := scan.Errors(); len(errs) > 0 {
+		return errs
+	}
 	return nil
 }
@@ -6,6 +6,7 @@ package lib
 
 import (
 	"bytes"
+	"errors"
 	"os"
 	"path/filepath"
 	"strings"
@@ -70,6 +71,102 @@ func TestFileWithContinuationRecord(t *testing.T) {
 	require.Equal(t, expected, f.String())
 }
 
+func TestFileWithContinuedHeadersAndTrailers(t *testing.T) {
+
+	raw := `01,0004,12345,060321,0829/
+88,001,80,1,2/
+02,12345,0004,1/
+88,060317,,CAD,/
+03,10200123456,CAD,040,+000000000000,,/
+16,409,000000000002500,V,060316,1300,,,RETURNED CHEQUE/
+49,+00000000000002500/
+88,4/
+98,+00000000000002500,1/
+88,8/
+99,+00000000000002500/
+88,1,12/`
+
+	scan := NewBai2Scanner(strings.NewReader(raw))
+	f := NewBai2()
+	require.NoError(t, f.Read(&scan))
+	require.NoError(t, f.Validate())
+	require.NoError(t, f.ValidateIntegrity())
+
+	require.Equal(t, "001", f.FileIdNumber)
+	require.Equal(t, int64(2), f.VersionNumber)
+	require.Equal(t, "060317", f.Groups[0].AsOfDate)
+	require.Equal(t, "CAD", f.Groups[0].CurrencyCode)
+	require.Equal(t, int64(4), f.Groups[0].Accounts[0].NumberRecords)
+	require.Equal(t, int64(8), f.Groups[0].NumberOfRecords)
+	require.Equal(t, int64(12), f.NumberOfRecords)
+
+	// records are written on a single line unless they exceed the physical record length
+	require.Equal(t, `01,0004,12345,060321,0829,001,80,1,2/
+02,12345,0004,1,060317,,CAD,/
+03,10200123456,CAD,040,+000000000000,,/
+16,409,000000000002500,V,060316,1300,,,RETURNED CHEQUE/
+49,+00000000000002500,4/
+98,+00000000000002500,1,8/
+99,+00000000000002500,1,12/`, f.String())
+}
+
+func TestFileContinuationRoundTrip(t *testing.T) {
+	file := Bai2{
+		Sender:               "0004",
+		Receiver:             "12345",
+		FileCreatedDate:      "060321",
+		FileCreatedTime:      "0829",
+		FileIdNumber:         "001",
+		PhysicalRecordLength: 20,
+		VersionNumber:        2,
+		Groups: []Group{{
+			Receiver:         "12345",
+			Originator:       "0004",
+			GroupStatus:      GroupStatusUpdate,
+			AsOfDate:         "060317",
+			AsOfTime:         "0800",
+			CurrencyCode:     "CAD",
+			AsOfDateModifier: AsOfFinalPreviousDay,
+			Accounts: []Account{{
+				AccountNumber: "10200123456",
+				Summaries:     []AccountSummary{{TypeCode: TypeCodeOpeningLedger, Amount: "100000"}},
+				Details:       []Detail{{TypeCode: TypeCodeLockboxDeposit, Amount: "2500"}},
+			}},
+		}},
+	}
+	require.NoError(t, file.Finalize())
+
+	output := file.String()
+	require.Equal(t, `01,0004,12345/
+88,060321,0829,001/
+88,20,,2/
+02,12345,0004,1/
+88,060317,0800,CAD/
+88,2/
+03,10200123456,/
+88,010,100000,,/
+16,115,2500,,,,/
+49,102500,4/
+98,102500,1,8/
+99,102500,1,12/`, output)
+	require.Equal(t, int64(12), file.NumberOfRecords)
+	require.NoError(t, file.ValidateIntegrity())
+
+	scan := NewBai2Scanner(strings.NewReader(output))
+	read := NewBai2()
+	require.NoError(t, read.Read(&scan))
+	require.NoError(t, read.Validate())
+	require.NoError(t, read.ValidateIntegrity())
+	require.Equal(t, output, read.String())
+
+	require.Equal(t, file.FileIdNumber, read.FileIdNumber)
+	require.Equal(t, file.PhysicalRecordLength, read.PhysicalRecordLength)
+	require.Equal(t, file.Groups[0].CurrencyCode, read.Groups[0].CurrencyCode)
+	require.Equal(t, file.Groups[0].AsOfDateModifier, read.Groups[0].AsOfDateModifier)
+	require.Equal(t, file.Groups[0].NumberOfRecords, read.Groups[0].NumberOfRecords)
+	require.Equal(t, file.Groups[0].Accounts[0].NumberRecords, read.Groups[0].Accounts[0].NumberRecords)
+}
+
 func TestSumFileRecords(t *testing.T) {
 	file := Bai2{}
 	file.Groups = []Group{
@@ -210,3 +307,280 @@ func TestBuildFileAggregates(t *testing.T) {
 	require.Equal(t, int64(29), file.NumberOfRecords)
 
 }
+
+func TestFileRead_ReadError(t *testing.T) {
+	raw := `01,0004,12345,060321,0829,001,80,1,2/
+02,12345,0004,1,060317,,CAD,/
+03,10200123456,CAD,040,+000000000000,,,045,+000000000000,,/
+16,409,000000000002500,V,060316,1300,,,RETURNED CHEQUE     /
+`
+
+	scan := NewBai2Scanner(failingReader(raw))
+	f := NewBai2()
+	err := f.Read(&scan)
+	require.ErrorIs(t, err, errTestRead)
+}
+
+func TestFileRead_ContinueOnError(t *testing.T) {
+	raw := `01,0004,12345,060321,0829,001,80,1,2/
+02,12345,0004,1,060317,,CAD,/
+03,10200123456,CAD,040,+000000000000,,/
+16,409,00000A000002500,,,,RETURNED CHEQUE/
+88,MORE TEXT/
+16,409,000000000090000,,,,RTN-UNKNOWN/
+49,+00000000000834000,X/
+77,UNKNOWN,RECORD/
+88,CONTINUED/
+03,10200654321,USD,040,+000000000000,,/
+16,108,000000000011500,,,,TFR/
+98,+00000000001280000,2,11/
+99,+00000000001280000,1,14/`
+
+	// By default, reading stops at the first error
+	scan := NewBai2Scanner(strings.NewReader(raw))
+	err := NewBai2().Read(&scan)
+	require.Equal(t, "ERROR parsing transaction detail on line 4 (TransactionDetail: invalid Amount)", err.Error())
+
+	scan = NewBai2Scanner(strings.NewReader(raw), ContinueOnError())
+	f := NewBai2()
+	err = f.Read(&scan)
+	require.Error(t, err)
+
+	var errs ErrorList
+	require.True(t, errors.As(err, &errs))
+	require.Equal(t, scan.Errors(), errs)
+	require.Len(t, errs, 4)
+
+	expected := []struct {
+		line   int
+		record string
+		field  string
+	}{
+		{line: 4, record: "16", field: "Amount"},
+		{line: 7, record: "49", field: "NumberRecords"},
+		{line: 8, record: "77", field: "RecordCode"},
+		{line: 12, record: "98", field: "RecordCode"},
+	}
+	for i, want := range expected {
+		var pErr *ParseError
+		require.True(t, errors.As(errs[i], &pErr))
+		require.Equal(t, want.line, pErr.Line)
+		require.Equal(t, want.record, pErr.Record)
+		require.Equal(t, want.field, pErr.Field)
+	}
+	require.Contains(t, errs[3].Error(), "missing account trailer")
+
+	// The rest of the file is still available
+	require.Equal(t, "0004", f.Sender)
+	require.Len(t, f.Groups, 1)
+	require.Equal(t, "+00000000001280000", f.Groups[0].GroupControlTotal)
+	require.Len(t, f.Groups[0].Accounts, 2)
+	require.Equal(t, "10200123456", f.Groups[0].Accounts[0].AccountNumber)
+	require.Len(t, f.Groups[0].Accounts[0].Details, 1)
+	require.Equal(t, "RTN-UNKNOWN/", f.Groups[0].Accounts[0].Details[0].Text)
+	require.Equal(t, "10200654321", f.Groups[0].Accounts[1].AccountNumber)
+	require.Len(t, f.Groups[0].Accounts[1].Details, 1)
+	require.Equal(t, int64(14), f.NumberOfRecords)
+}
+
+func TestFileRead_MissingAccountTrailer(t *testing.T) {
+	raw := `01,0004,12345,060321,0829,001,80,1,2/
+02,12345,0004,1,060317,,CAD,/
+03,10200123456,CAD,040,+000000000000,,/
+16,409,000000000002500,,,,RETURNED CHEQUE/
+03,10200654321,CAD,040,+000000000000,,/
+16,108,000000000011500,,,,TFR/
+49,+00000000000014000,3/
+98,+00000000000014000,2,7/
+99,+00000000000014000,1,9/`
+
+	scan := NewBai2Scanner(strings.NewReader(raw))
+	err := NewBai2().Read(&scan)
+	require.Equal(t, "ERROR parsing account on line 5 (missing account trailer)", err.Error())
+
+	// The next account starts on the record that ended the previous one
+	scan = NewBai2Scanner(strings.NewReader(raw), ContinueOnError())
+	f := NewBai2()
+	err = f.Read(&scan)
+
+	var errs ErrorList
+	require.True(t, errors.As(err, &errs))
+	require.Len(t, errs, 1)
+
+	var pErr *ParseError
+	require.True(t, errors.As(errs[0], &pErr))
+	require.Equal(t, 5, pErr.Line)
+	require.Equal(t, "03", pErr.Record)
+
+	require.Len(t, f.Groups, 1)
+	accounts := f.Groups[0].Accounts
+	require.Len(t, accounts, 2)
+	require.Equal(t, "10200123456", accounts[0].AccountNumber)
+	require.Len(t, accounts[0].Details, 1)
+	require.Equal(t, "409", accounts[0].Details[0].TypeCode)
+	require.Equal(t, "10200654321", accounts[1].AccountNumber)
+	require.Len(t, accounts[1].Details, 1)
+	require.Equal(t, "108", accounts[1].Details[0].TypeCode)
+	require.Equal(t, "+00000000000014000", accounts[1].AccountControlTotal)
+}
+
+func TestFileRead_MissingAccountTrailerBeforeGroupTrailer(t *testing.T) {
+	raw := `01,0004,12345,060321,0829,001,80,1,2/
+02,12345,0004,1,060317,,CAD,/
+03,10200123456,CAD,040,+000000000000,,/
+16,409,000000000002500,,,,RETURNED CHEQUE/
+98,+00000000000002500,1,4/
+99,+00000000000002500,1,6/`
+
+	scan := NewBai2Scanner(strings.NewReader(raw))
+	err := NewBai2().Read(&scan)
+	require.Equal(t, "ERROR parsing account on line 5 (missing account trailer)", err.Error())
+
+	scan = NewBai2Scanner(strings.NewReader(raw), ContinueOnError())
+	f := NewBai2()
+	err = f.Read(&scan)
+
+	var errs ErrorList
+	require.True(t, errors.As(err, &errs))
+	require.Len(t, errs, 1)
+	require.Contains(t, errs[0].Error(), "missing account trailer")
+
+	// The group trailer still ends the group
+	require.Len(t, f.Groups, 1)
+	require.Equal(t, "+00000000000002500", f.Groups[0].GroupControlTotal)
+	require.Len(t, f.Groups[0].Accounts, 1)
+	require.Len(t, f.Groups[0].Accounts[0].Details, 1)
+}
+
+func TestFileRead_MissingGroupTrailer(t *testing.T) {
+	raw := `01,0004,12345,060321,0829,001,80,1,2/
+02,12345,0004,1,060317,,CAD,/
+03,10200123456,CAD,040,+000000000000,,/
+49,+00000000000000000,2/
+02,12345,0004,1,060318,,CAD,/
+03,10200123456,CAD,040,+000000000000,,/
+49,+00000000000000000,2/
+98,+00000000000000000,1,4/
+99,+00000000000000000,2,10/`
+
+	scan := NewBai2Scanner(strings.NewReader(raw))
+	err := NewBai2().Read(&scan)
+	require.Equal(t, "ERROR parsing group on line 5 (missing group trailer)", err.Error())
+
+	scan = NewBai2Scanner(strings.NewReader(raw), ContinueOnError())
+	f := NewBai2()
+	err = f.Read(&scan)
+
+	var errs ErrorList
+	require.True(t, errors.As(err, &errs))
+	require.Len(t, errs, 1)
+	require.Len(t, f.Groups, 2)
+	require.Equal(t, "060317", f.Groups[0].AsOfDate)
+	require.Equal(t, "060318", f.Groups[1].AsOfDate)
+}
+
+func TestFileValidateIntegrity(t *testing.T) {
+	for _, path := range []string{"sample1.txt", "sample2.txt"} {
+		fd, err := os.Open(filepath.Join("..", "..", "test", "testdata", path))
+		require.NoError(t, err)
+
+		scan := NewBai2Scanner(fd)
+		f := NewBai2()
+		require.NoError(t, f.Read(&scan))
+		require.NoError(t, f.ValidateIntegrity())
+	}
+
+	raw := `01,0004,12345,060321,0829,001,80,1,2/
+02,12345,0004,1,060317,,CAD,/
+03,10200123456,CAD,040,+000000000000,,,045,+000000000000,,/
+88,046,+000000000000,,,047,+000000000000,,,048,+000000000000,,,049,+000000000000,,/
+88,050,+000000000000,,,051,+000000000000,,,052,+000000000000,,,053,+000000000000,,/
+16,409,000000000002500,V,060316,1300,,,RETURNED CHEQUE     /
+16,409,000000000090000,V,060316,1300,,,RTN-UNKNOWN         /
+49,+00000000000834000,14/
+98,+00000000001280000,2,25/
+99,+00000000001280000,1,27/`
+
+	scan := NewBai2Scanner(strings.NewReader(raw))
+	f := NewBai2()
+	require.NoError(t, f.Read(&scan))
+	require.NoError(t, f.Validate())
+
+	err := f.ValidateIntegrity()
+	require.Error(t, err)
+
+	var errs ErrorList
+	require.True(t, errors.As(err, &errs))
+	require.Len(t, errs, 6)
+
+	var iErr *IntegrityError
+	require.True(t, errors.As(errs[0], &iErr))
+	require.Equal(t, "49", iErr.Record)
+	require.Equal(t, "NumberRecords", iErr.Field)
+	require.Equal(t, "group 1, account 10200123456", iErr.Location)
+	require.Equal(t, "6", iErr.Expected)
+	require.Equal(t, "14", iErr.Actual)
+
+	require.Equal(t, `ERROR validating account trailer in group 1, account 10200123456 (NumberRecords is 14, expected 6)
+ERROR validating account trailer in group 1, account 10200123456 (AccountControlTotal is +00000000000834000, expected 92500)
+ERROR validating group trailer in group 1 (NumberOfAccounts is 2, expected 1)
+ERROR validating group trailer in group 1 (NumberOfRecords is 25, expected 8)
+ERROR validating group trailer in group 1 (GroupControlTotal is +00000000001280000, expected 834000)
+ERROR validating file trailer (NumberOfRecords is 27, expected 10)`, err.Error())
+}
+
+func TestFileFinalize(t *testing.T) {
+	account := Account{
+		AccountNumber: "10200123456",
+		CurrencyCode:  "CAD",
+		Summaries: []AccountSummary{
+			{TypeCode: "040", Amount: "100000"},
+			{TypeCode: "045", Amount: "150000"},
+			{TypeCode: "100", Amount: "92500", ItemCount: 2},
+		},
+		Details: []Detail{
+			{TypeCode: "409", Amount: "2500", Text: "RETURNED CHEQUE"},
+			{TypeCode: "409", Amount: "90000", Text: "RTN-UNKNOWN"},
+		},
+	}
+
+	file := Bai2{
+		Sender:               "0004",
+		Receiver:             "12345",
+		FileCreatedDate:      "060321",
+		FileCreatedTime:      "0829",
+		FileIdNumber:         "001",
+		PhysicalRecordLength: 40,
+		BlockSize:            1,
+		VersionNumber:        2,
+		Groups: []Group{
+			{Receiver: "12345", Originator: "0004", GroupStatus: 1, AsOfDate: "060317", Accounts: []Account{account, account}},
+			{Receiver: "12345", Originator: "0004", GroupStatus: 1, AsOfDate: "060318", Accounts: []Account{account}},
+		},
+	}
+
+	require.NoError(t, file.Finalize())
+	require.NoError(t, file.Validate())
+	require.NoError(t, file.ValidateIntegrity())
+
+	require.Equal(t, "435000", file.Groups[0].Accounts[0].AccountControlTotal)
+	require.Equal(t, int64(5), file.Groups[0].Accounts[0].NumberRecords)
+	require.Equal(t, "870000", file.Groups[0].GroupControlTotal)
+	require.Equal(t, int64(2), file.Groups[0].NumberOfAccounts)
+	require.Equal(t, int64(12), file.Groups[0].NumberOfRecords)
+	require.Equal(t, "1305000", file.FileControlTotal)
+	require.Equal(t, int64(2), file.NumberOfGroups)
+	require.Equal(t, int64(21), file.NumberOfRecords)
+
+	// every physical record of the output is counted, including continuations
+	require.Equal(t, 21, strings.Count(file.String(), "\n")+1)
+
+	// the record counts of a file that was read are replaced as well
+	scan := NewBai2Scanner(strings.NewReader(file.String()))
+	read := NewBai2()
+	require.NoError(t, read.Read(&scan))
+	read.PhysicalRecordLength = 80
+	require.NoError(t, read.Finalize())
+	require.Equal(t, int64(18), read.NumberOfRecords)
+	require.NoError(t, read.ValidateIntegrity())
+}
@@ -0,0 +1,272 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/bai2/pkg/util"
+)
+
+/*
+
+FIXED-BLOCK FILES
+
+The physical record length and block size of the file header describe files made of fixed-length physical
+records, padded with spaces after their last significant character, and grouped into blocks of a number of
+physical records. Such files are often transferred without line terminators.
+
+Blocks hold whole physical records, so deblocking a file only requires splitting it into physical records
+of the declared length. Line terminators following a physical record or a block are skipped.
+
+When writing, records longer than the physical record length are split into continuation records, every
+physical record is padded with spaces to its length, and records are grouped into blocks of the block size.
+Line terminators are written after every physical record, after every block, or not at all.
+
+*/
+
+// headerPeekSize is the number of bytes searched for the file header of a file with fixed-length records
+const headerPeekSize = 512
+
+// FixedLengthRecords configures the scanner to read fixed-length physical records of the given length, which are
+// not separated by line terminators. When length is 0, the physical record length declared by the file header is
+// used, and records are only split when the file is not made of variable-length records.
+func FixedLengthRecords(length int64) ScannerOption {
+	return func(b *Bai2Scanner) {
+		b.fixedLength = true
+		b.recordLength = length
+	}
+}
+
+// deblockReader splits a stream of fixed-length physical records into lines. Record lengths are counted in
+// characters, which may take several bytes once transcoded from a single-byte character set.
+type deblockReader struct {
+	src          *bufio.Reader
+	recordLength int64
+	// number of characters of the current physical record read so far
+	column int64
+	// bytes of a character that did not fit in the last read
+	pending []byte
+}
+
+func newDeblockReader(src io.Reader, recordLength int64) io.Reader {
+	reader := bufio.NewReader(src)
+	if recordLength <= 0 {
+		recordLength = declaredRecordLength(reader)
+	}
+	if recordLength <= 0 {
+		return reader
+	}
+	return &deblockReader{src: reader, recordLength: recordLength}
+}
+
+// declaredRecordLength returns the physical record length declared by the file header, or 0 when the header does
+// not declare one or when its physical record is terminated before the declared length
+func declaredRecordLength(reader *bufio.Reader) int64 {
+	data, _ := reader.Peek(headerPeekSize)
+
+	start := bytes.IndexFunc(data, func(r rune) bool { return r != ' ' && r != '\r' && r != '\n' })
+	if start < 0 {
+		return 0
+	}
+	header := string(data[start:])
+	end := strings.Index(header, "/")
+	if end < 0 || !strings.HasPrefix(header, util.FileHeaderCode+",") {
+		return 0
+	}
+
+	fields := strings.Split(header[:end], ",")
+	if len(fields) < 7 {
+		return 0
+	}
+	length, err := strconv.ParseInt(fields[6], 10, 64)
+	if err != nil || length <= 0 {
+		return 0
+	}
+
+	// padded records are only terminated once they reach their length
+	if terminator := bytes.IndexAny(data[start:], "\r\n"); terminator >= 0 && int64(terminator) < length {
+		return 0
+	}
+	return length
+}
+
+func (d *deblockReader) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		if len(d.pending) > 0 {
+			copied := copy(p[n:], d.pending)
+			d.pending = d.pending[copied:]
+			n += copied
+			continue
+		}
+
+		if d.column == d.recordLength {
+			p[n] = '\n'
+			n++
+			d.column = 0
+			continue
+		}
+
+		r, size, err := d.src.ReadRune()
+		if err != nil {
+			if n > 0 {
+				return n, nil
+			}
+			return 0, err
+		}
+
+		// line terminators following physical records or blocks
+		if d.column == 0 && (r == '\r' || r == '\n') {
+			continue
+		}
+		d.column++
+
+		if size == 1 {
+			// bytes that are not valid UTF-8 are passed on as is
+			_ = d.src.UnreadRune()
+			p[n], _ = d.src.ReadByte()
+			n++
+			continue
+		}
+		d.pending = append(d.pending[:0], string(r)...)
+	}
+	return n, nil
+}
+
+// FixedBlockOption configures the fixed-block output of Bai2.FixedBlock
+type FixedBlockOption func(*fixedBlockWriter)
+
+// WithTerminator sets the line terminator written after physical records or blocks, which is a newline by default.
+// No line terminators are written when it is empty.
+func WithTerminator(terminator string) FixedBlockOption {
+	return func(f *fixedBlockWriter) {
+		f.terminator = terminator
+	}
+}
+
+// TerminateBlocks writes the line terminator after every block rather than after every physical record
+func TerminateBlocks() FixedBlockOption {
+	return func(f *fixedBlockWriter) {
+		f.terminateBlocks = true
+	}
+}
+
+// PadLastBlock fills up the last block with blank physical records
+func PadLastBlock() FixedBlockOption {
+	return func(f *fixedBlockWriter) {
+		f.padLastBlock = true
+	}
+}
+
+// fixedBlockWriter writes physical records padded to their length and grouped into blocks
+type fixedBlockWriter struct {
+	w            io.Writer
+	recordLength int64
+	blockSize    int64
+
+	terminator      string
+	terminateBlocks bool
+	padLastBlock    bool
+
+	// number of physical records written so far, and in the current block
+	records      int64
+	blockRecords int64
+}
+
+func newFixedBlockWriter(w io.Writer, recordLength, blockSize int64, opts ...FixedBlockOption) (*fixedBlockWriter, error) {
+	if recordLength <= 0 {
+		return nil, errors.New("FixedBlock: physical record length is required")
+	}
+	if blockSize < 0 {
+		return nil, errors.New("FixedBlock: invalid block size")
+	}
+
+	f := &fixedBlockWriter{w: w, recordLength: recordLength, blockSize: blockSize, terminator: "\n"}
+	for _, opt := range opts {
+		opt(f)
+	}
+	return f, nil
+}
+
+// writeRecord writes a physical record padded with spaces to the physical record length, which counts characters
+// rather than bytes like the deblocking reader
+func (f *fixedBlockWriter) writeRecord(record string) error {
+	f.records++
+	if int64(utf8.RuneCountInString(record)) > f.recordLength {
+		return fmt.Errorf("FixedBlock: physical record %d is longer than %d characters", f.records, f.recordLength)
+	}
+	return f.write(record)
+}
+
+func (f *fixedBlockWriter) write(record string) error {
+	padded := record + strings.Repeat(" ", int(f.recordLength)-utf8.RuneCountInString(record))
+	if !f.terminateBlocks {
+		padded += f.terminator
+	}
+	if _, err := io.WriteString(f.w, padded); err != nil {
+		return err
+	}
+
+	f.blockRecords++
+	if f.blockRecords == f.blockSize {
+		return f.endBlock()
+	}
+	return nil
+}
+
+func (f *fixedBlockWriter) endBlock() error {
+	f.blockRecords = 0
+	if f.terminateBlocks {
+		_, err := io.WriteString(f.w, f.terminator)
+		return err
+	}
+	return nil
+}
+
+// close ends the last block, which is filled up with blank physical records when configured
+func (f *fixedBlockWriter) close() error {
+	if f.blockRecords == 0 {
+		return nil
+	}
+	if f.padLastBlock && f.blockSize > 0 {
+		for f.blockRecords > 0 {
+			if err := f.write(""); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return f.endBlock()
+}
+
+// FixedBlock returns the file made of physical records of PhysicalRecordLength, padded with spaces and grouped
+// into blocks of BlockSize physical records. Records that exceed the physical record length are written as
+// continuation records, as is done by String.
+func (r *Bai2) FixedBlock(opts ...FixedBlockOption) (string, error) {
+	var buf strings.Builder
+	f, err := newFixedBlockWriter(&buf, r.PhysicalRecordLength, r.BlockSize, opts...)
+	if err != nil {
+		return "", err
+	}
+
+	for _, record := range strings.Split(r.String(), "\n") {
+		if err := f.writeRecord(record); err != nil {
+			return "", err
+		}
+	}
+	if err := f.close(); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
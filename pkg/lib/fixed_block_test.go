@@ -0,0 +1,192 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/unicode"
+)
+
+var fixedLengthRecords = []string{
+	"01,0004,12345,060321,0829,001,50,2,2/",
+	"02,12345,0004,1,060317,,CAD,/",
+	"03,10200123456,CAD,040,+000000000000,,/",
+	"16,409,000000000002500,V,060316,1300,,,CHEQUE 1234",
+	"88,RETURNED/",
+	"49,+00000000000002500,4/",
+	"98,+00000000000002500,1,6/",
+	"99,+00000000000002500,1,8/",
+}
+
+// padRecords writes the records padded to the length, separated by the terminator
+func padRecords(records []string, length int, terminator string) string {
+	var buf strings.Builder
+	for _, record := range records {
+		buf.WriteString(fmt.Sprintf("%-*s", length, record))
+		buf.WriteString(terminator)
+	}
+	return buf.String()
+}
+
+func TestFixedLengthRecords(t *testing.T) {
+	blocked := padRecords(fixedLengthRecords, 50, "")
+	// the last block is filled up with blank records
+	blocked += strings.Repeat(" ", 50)
+
+	testCases := []struct {
+		name string
+		data string
+		opt  ScannerOption
+	}{
+		{"explicit length", blocked, FixedLengthRecords(50)},
+		{"header length", blocked, FixedLengthRecords(0)},
+		{"terminated records", padRecords(fixedLengthRecords, 50, "\r\n"), FixedLengthRecords(0)},
+	}
+
+	for _, tc := range testCases {
+		scan := NewBai2Scanner(strings.NewReader(tc.data), tc.opt)
+		file := NewBai2()
+		require.NoError(t, file.Read(&scan), tc.name)
+		require.NoError(t, file.Validate(), tc.name)
+		require.NoError(t, file.ValidateIntegrity(), tc.name)
+
+		require.Equal(t, int64(50), file.PhysicalRecordLength, tc.name)
+		require.Equal(t, int64(2), file.BlockSize, tc.name)
+		detail := file.Groups[0].Accounts[0].Details[0]
+		require.Equal(t, "CHEQUE 1234,RETURNED/", detail.Text, tc.name)
+	}
+}
+
+func TestFixedLengthRecordsWithVariableLengthFile(t *testing.T) {
+	// sample1 declares a physical record length of 80 without padding its records
+	data, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "sample1.txt"))
+	require.NoError(t, err)
+
+	scan := NewBai2Scanner(strings.NewReader(string(data)), FixedLengthRecords(0))
+	file := NewBai2()
+	require.NoError(t, file.Read(&scan))
+	require.NoError(t, file.Validate())
+
+	expected := NewBai2Scanner(strings.NewReader(string(data)))
+	expectedFile := NewBai2()
+	require.NoError(t, expectedFile.Read(&expected))
+	require.Equal(t, expectedFile.String(), file.String())
+}
+
+func TestFileFixedBlock(t *testing.T) {
+	file, err := NewFileBuilder().
+		Sender("0004").
+		Receiver("12345").
+		Created(time.Date(2006, time.March, 21, 8, 29, 0, 0, time.UTC)).
+		FileID("001").
+		PhysicalRecordLength(40).
+		BlockSize(3).
+		Group("0004", time.Date(2006, time.March, 17, 0, 0, 0, 0, time.UTC)).
+		GroupCurrency("CAD").
+		Account("10200123456").
+		Summary(TypeCodeOpeningLedger, 100000, 0).
+		Summary(TypeCodeClosingLedger, 102500, 0).
+		Credit(TypeCodeLockboxDeposit, 2500, WithText("LOCK BOX")).
+		Build()
+	require.NoError(t, err)
+
+	// physical records are padded and blocks are filled up without line terminators
+	output, err := file.FixedBlock(WithTerminator(""), PadLastBlock())
+	require.NoError(t, err)
+	require.NotContains(t, output, "\n")
+	require.Zero(t, len(output)%(40*3))
+	require.Equal(t, fmt.Sprintf("%-40s", "01,0004,12345,060321,0829,001,40,3,2/"), output[:40])
+
+	scan := NewBai2Scanner(strings.NewReader(output), FixedLengthRecords(0))
+	read := NewBai2()
+	require.NoError(t, read.Read(&scan))
+	require.NoError(t, read.ValidateIntegrity())
+	require.Equal(t, file.String(), read.String())
+
+	// line terminators after every block
+	output, err = file.FixedBlock(WithTerminator("\r\n"), TerminateBlocks())
+	require.NoError(t, err)
+	blocks := strings.Split(strings.TrimSuffix(output, "\r\n"), "\r\n")
+	for _, block := range blocks[:len(blocks)-1] {
+		require.Len(t, block, 40*3)
+	}
+	require.Len(t, blocks[len(blocks)-1], 40*(int(file.NumberOfRecords)%3))
+
+	scan = NewBai2Scanner(strings.NewReader(output), FixedLengthRecords(40))
+	read = NewBai2()
+	require.NoError(t, read.Read(&scan))
+	require.Equal(t, file.String(), read.String())
+
+	// line terminators after every physical record
+	output, err = file.FixedBlock()
+	require.NoError(t, err)
+	for _, record := range strings.Split(strings.TrimSuffix(output, "\n"), "\n") {
+		require.Len(t, record, 40)
+	}
+
+	file.Groups[0].Accounts[0].Details[0].Text = strings.Repeat("X", 41)
+	_, err = file.FixedBlock()
+	require.EqualError(t, err, "FixedBlock: physical record 6 is longer than 40 characters")
+
+	file.PhysicalRecordLength = 0
+	_, err = file.FixedBlock()
+	require.EqualError(t, err, "FixedBlock: physical record length is required")
+}
+
+func TestFixedBlockOutputNonASCII(t *testing.T) {
+	file, err := NewFileBuilder().
+		Sender("0004").
+		Receiver("12345").
+		Created(time.Date(2006, time.March, 21, 8, 29, 0, 0, time.UTC)).
+		FileID("001").
+		PhysicalRecordLength(80).
+		BlockSize(2).
+		Group("0004", time.Date(2006, time.March, 17, 0, 0, 0, 0, time.UTC)).
+		GroupCurrency("EUR").
+		Account("10200123456").
+		Summary(TypeCodeOpeningLedger, 100000, 0).
+		Credit(TypeCodeLockboxDeposit, 2500, WithText(strings.Repeat("Café payée,", 12))).
+		Build()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		encoding encoding.Encoding
+	}{
+		{"utf-8", unicode.UTF8},
+		{"windows-1252", charmap.Windows1252},
+	}
+
+	for _, tc := range testCases {
+		var buf bytes.Buffer
+		writer := NewWriter(&buf, OutputEncoding(tc.encoding), FixedBlockOutput(WithTerminator(""), PadLastBlock()))
+		require.NoError(t, writer.Write(file), tc.name)
+		require.NoError(t, writer.Close(), tc.name)
+
+		// physical records are padded by characters, which take a single byte in windows-1252
+		if tc.encoding == charmap.Windows1252 {
+			require.Zero(t, buf.Len()%80, tc.name)
+		} else {
+			require.Zero(t, utf8.RuneCount(buf.Bytes())%80, tc.name)
+		}
+
+		scan := NewBai2Scanner(bytes.NewReader(buf.Bytes()), InputEncoding(tc.encoding), FixedLengthRecords(0))
+		read := NewBai2()
+		require.NoError(t, read.Read(&scan), tc.name)
+		require.NoError(t, read.ValidateIntegrity(), tc.name)
+		require.Equal(t, file.String(), read.String(), tc.name)
+	}
+}
@@ -42,13 +42,13 @@ func NewGroup() *Group {
 // Group Format
 type Group struct {
 	// Group Header
-	Receiver         string `json:"receiver,omitempty"`
-	Originator       string `json:"originator"`
-	GroupStatus      int64  `json:"groupStatus"`
-	AsOfDate         string `json:"asOfDate"`
-	AsOfTime         string `json:"asOfTime,omitempty"`
-	CurrencyCode     string `json:"currencyCode,omitempty"`
-	AsOfDateModifier int64  `json:"asOfDateModifier,omitempty"`
+	Receiver         string           `json:"receiver,omitempty"`
+	Originator       string           `json:"originator"`
+	GroupStatus      GroupStatus      `json:"groupStatus"`
+	AsOfDate         string           `json:"asOfDate"`
+	AsOfTime         string           `json:"asOfTime,omitempty"`
+	CurrencyCode     string           `json:"currencyCode,omitempty"`
+	AsOfDateModifier AsOfDateModifier `json:"asOfDateModifier,omitempty"`
 
 	// Group Trailer
 	GroupControlTotal string `json:"groupControlTotal"`
@@ -59,6 +59,11 @@ type Group struct {
 
 	header  groupHeader
 	trailer groupTrailer
+
+	// number of physical records the group was read from
+	parsedRecords int64
+	// registries interpreting the group
+	registries registries
 }
 
 func (r *Group) copyRecords() {
@@ -79,16 +84,34 @@ func (r *Group) copyRecords() {
 		NumberOfRecords:   r.NumberOfRecords,
 	}
 
+	for i := range r.Accounts {
+		r.Accounts[i].originator = r.Originator
+		r.Accounts[i].groupCurrency = r.CurrencyCode
+		r.Accounts[i].registries = r.registries
+	}
+
 }
 
-// Sums the number of 02,03,16,88,49,98 records in the group. Maps to the NumberOfRecords field
-func (g *Group) SumRecords() int64 {
+// Sums the number of 02,03,16,88,49,98 records in the group. Maps to the NumberOfRecords field.
+// The optional argument is the physical record length used to split the group header and trailer into continuations.
+func (g *Group) SumRecords(opts ...int64) int64 {
 	var sum int64
 	for _, account := range g.Accounts {
 		sum += account.NumberRecords
 	}
-	// Add two for the group header and trailer records
-	return sum + 2
+	return g.envelopeRecords(sum, opts...)
+}
+
+// envelopeRecords returns the number of physical records of the group holding accounts of the given number of records
+func (g *Group) envelopeRecords(accounts int64, opts ...int64) int64 {
+	g.copyRecords()
+
+	sum := countRecords(g.header.string(opts...)) + accounts
+	return withTrailer(sum, func(count int64) string {
+		trailer := g.trailer
+		trailer.NumberOfRecords = count
+		return trailer.string(opts...)
+	})
 }
 
 // Sums the number of accounts in the group. Maps to the NumberOfAccounts field
@@ -112,21 +135,115 @@ func (a *Group) SumAccountControlTotals() (string, error) {
 	return fmt.Sprint(sum), nil
 }
 
+// recordCount returns the number of physical records the group was read from,
+// or the number of records it is written as when it was not read from a file
+func (r *Group) recordCount(opts ...int64) int64 {
+	if r.parsedRecords > 0 {
+		return r.parsedRecords
+	}
+	var sum int64
+	for i := range r.Accounts {
+		sum += r.Accounts[i].recordCount(opts...)
+	}
+	return r.envelopeRecords(sum, opts...)
+}
+
+// ValidateIntegrity compares the group trailer and the trailers of its accounts with their contents, and returns
+// an ErrorList holding an IntegrityError for every mismatch. The optional argument is the physical record length
+// used to count the records of accounts that were not read from a file.
+func (r *Group) ValidateIntegrity(opts ...int64) error {
+	if errs := r.integrityErrors(opts...); len(errs) > 0 {
+		return ErrorList(errs)
+	}
+	return nil
+}
+
+func (r *Group) integrityErrors(opts ...int64) []error {
+	var errs []error
+
+	var total int64
+	totalErr := false
+	for i := range r.Accounts {
+		errs = append(errs, r.Accounts[i].integrityErrors(opts...)...)
+
+		amt, err := parseControlTotal(r.Accounts[i].AccountControlTotal)
+		if err != nil {
+			// already reported against the account trailer
+			totalErr = true
+		}
+		total += amt
+	}
+
+	if expected := int64(len(r.Accounts)); expected != r.NumberOfAccounts {
+		errs = append(errs, &IntegrityError{
+			Record:   util.GroupTrailerCode,
+			Field:    "NumberOfAccounts",
+			Expected: fmt.Sprint(expected),
+			Actual:   fmt.Sprint(r.NumberOfAccounts),
+		})
+	}
+
+	if expected := r.recordCount(opts...); expected != r.NumberOfRecords {
+		errs = append(errs, &IntegrityError{
+			Record:   util.GroupTrailerCode,
+			Field:    "NumberOfRecords",
+			Expected: fmt.Sprint(expected),
+			Actual:   fmt.Sprint(r.NumberOfRecords),
+		})
+	}
+
+	if actual, err := parseControlTotal(r.GroupControlTotal); !totalErr && (err != nil || actual != total) {
+		errs = append(errs, &IntegrityError{
+			Record:   util.GroupTrailerCode,
+			Field:    "GroupControlTotal",
+			Expected: fmt.Sprint(total),
+			Actual:   r.GroupControlTotal,
+		})
+	}
+
+	return errs
+}
+
+// Finalize sets the trailer fields of the group and of all its accounts from their contents.
+// The optional argument is the physical record length used to split records into continuations.
+func (r *Group) Finalize(opts ...int64) error {
+	var total int64
+	for i := range r.Accounts {
+		if err := r.Accounts[i].Finalize(opts...); err != nil {
+			return err
+		}
+		amt, err := parseControlTotal(r.Accounts[i].AccountControlTotal)
+		if err != nil {
+			return fmt.Errorf("ERROR finalizing group (invalid control total %q of account %s)", r.Accounts[i].AccountControlTotal, r.Accounts[i].AccountNumber)
+		}
+		total += amt
+	}
+
+	r.GroupControlTotal = fmt.Sprint(total)
+	r.NumberOfAccounts = r.SumNumberOfAccounts()
+	r.NumberOfRecords = r.SumRecords(opts...)
+	r.parsedRecords = 0
+
+	return nil
+}
+
 func (r *Group) String(opts ...int64) string {
 
 	r.copyRecords()
 
 	var buf bytes.Buffer
-	buf.WriteString(r.header.string() + "\n")
+	buf.WriteString(r.header.string(opts...) + "\n")
 	for i := range r.Accounts {
 		buf.WriteString(r.Accounts[i].String(opts...) + "\n")
 	}
-	buf.WriteString(r.trailer.string())
+	buf.WriteString(r.trailer.string(opts...))
 
 	return buf.String()
 }
 
-func (r *Group) Validate() error {
+// Validate checks the fields of all records of the group. The options set the registries used to interpret
+// the group instead of those it was read or built with.
+func (r *Group) Validate(opts ...RegistryOption) error {
 
 	r.copyRecords()
 
@@ -135,7 +252,7 @@ func (r *Group) Validate() error {
 	}
 
 	for i := range r.Accounts {
-		if err := r.Accounts[i].Validate(); err != nil {
+		if err := r.Accounts[i].Validate(opts...); err != nil {
 			return err
 		}
 	}
@@ -152,7 +269,21 @@ func (r *Group) Read(scan *Bai2Scanner, useCurrentLine bool) error {
 		return errors.New("invalid bai2 scanner")
 	}
 
+	unexpectedRecord := func(line string) error {
+		err := &fieldError{field: "RecordCode", offset: 0, err: fmt.Errorf("unable to read record type %s", line[0:2])}
+		return scan.collect(newParseError("group", scan.GetLineIndex(), line, err))
+	}
+
+	// The group ends on the first record of the next envelope when its trailer is missing
+	missingTrailer := func(line string) error {
+		err := &fieldError{field: "RecordCode", offset: 0, err: errors.New("missing group trailer")}
+		return scan.collect(newParseError("group", scan.GetLineIndex(), line, err))
+	}
+
+	r.registries = scan.registries
+
 	var err error
+	var headerLine int
 	for line := scan.ScanLine(useCurrentLine); line != ""; line = scan.ScanLine(useCurrentLine) {
 		useCurrentLine = false
 
@@ -163,10 +294,19 @@ func (r *Group) Read(scan *Bai2Scanner, useCurrentLine bool) error {
 
 		switch line[:2] {
 		case util.GroupHeaderCode:
+			if headerLine > 0 {
+				return missingTrailer(line)
+			}
+
+			headerLine = scan.GetLineIndex()
+			record := scan.readContinuations(line)
+
 			newRecord := groupHeader{}
-			_, err = newRecord.parse(line)
+			_, err = newRecord.parse(record)
 			if err != nil {
-				return fmt.Errorf("ERROR parsing group header on line %d (%v)", scan.GetLineIndex(), err)
+				if err = scan.collect(newParseError("group header", headerLine, record, err)); err != nil {
+					return err
+				}
 			}
 
 			r.Receiver = newRecord.Receiver
@@ -184,25 +324,49 @@ func (r *Group) Read(scan *Bai2Scanner, useCurrentLine bool) error {
 				return err
 			}
 
+			newAccount.originator = r.Originator
+			newAccount.groupCurrency = r.CurrencyCode
+			newAccount.registries = r.registries
+			newAccount.resolveCurrencies()
 			r.Accounts = append(r.Accounts, *newAccount)
 
+			// An account that is missing its trailer ends on the first record of the next envelope,
+			// which still needs to be processed.
+			useCurrentLine = !endsEnvelope(scan.GetLine(), util.AccountTrailerCode)
+
 		case util.GroupTrailerCode:
+			trailerLine := scan.GetLineIndex()
+			record := scan.readContinuations(line)
+
 			newRecord := groupTrailer{}
-			_, err = newRecord.parse(line)
+			_, err = newRecord.parse(record)
 			if err != nil {
-				return fmt.Errorf("ERROR parsing group trailer on line %d (%v)", scan.GetLineIndex(), err)
+				if err = scan.collect(newParseError("group trailer", trailerLine, record, err)); err != nil {
+					return err
+				}
 			}
 
 			r.GroupControlTotal = newRecord.GroupControlTotal
 			r.NumberOfAccounts = newRecord.NumberOfAccounts
 			r.NumberOfRecords = newRecord.NumberOfRecords
+			if headerLine > 0 {
+				r.parsedRecords = int64(scan.GetLineIndex() - headerLine + 1)
+			}
 
 			return nil
 
+		case util.FileHeaderCode, util.FileTrailerCode:
+			return missingTrailer(line)
+
 		default:
-			return fmt.Errorf("ERROR parsing group on line %d (unable to read record type %s)", scan.GetLineIndex(), line[0:2])
+			if err = unexpectedRecord(line); err != nil {
+				return err
+			}
+
+			scan.skipContinuations()
+			useCurrentLine = true
 		}
 	}
 
-	return nil
+	return scan.Err()
 }
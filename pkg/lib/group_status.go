@@ -0,0 +1,91 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+import (
+	"fmt"
+)
+
+// GroupStatus tells how the receiver processes the data of a group
+type GroupStatus int64
+
+const (
+	// GroupStatusUpdate replaces status and summary data with the same type codes and adds transaction details
+	GroupStatusUpdate GroupStatus = iota + 1
+	// GroupStatusDeletion removes all previously reported data of the accounts on the as-of date
+	GroupStatusDeletion
+	// GroupStatusCorrection replaces all previously reported data of the accounts on the as-of date
+	GroupStatusCorrection
+	// GroupStatusTestOnly is checked for syntax and totals but does not affect previously reported data
+	GroupStatusTestOnly
+)
+
+var groupStatusNames = map[GroupStatus]string{
+	GroupStatusUpdate:     "Update",
+	GroupStatusDeletion:   "Deletion",
+	GroupStatusCorrection: "Correction",
+	GroupStatusTestOnly:   "TestOnly",
+}
+
+// Valid reports whether the group status is defined by the specification
+func (s GroupStatus) Valid() bool {
+	_, ok := groupStatusNames[s]
+	return ok
+}
+
+func (s GroupStatus) String() string {
+	if name, ok := groupStatusNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("GroupStatus(%d)", int64(s))
+}
+
+// AsOfDateModifier tells whether the data of a group are previous-day or same-day data, and whether they are
+// interim or final
+type AsOfDateModifier int64
+
+const (
+	AsOfInterimPreviousDay AsOfDateModifier = iota + 1
+	AsOfFinalPreviousDay
+	AsOfInterimSameDay
+	AsOfFinalSameDay
+)
+
+var asOfDateModifierNames = map[AsOfDateModifier]string{
+	AsOfInterimPreviousDay: "InterimPreviousDay",
+	AsOfFinalPreviousDay:   "FinalPreviousDay",
+	AsOfInterimSameDay:     "InterimSameDay",
+	AsOfFinalSameDay:       "FinalSameDay",
+}
+
+// Valid reports whether the as-of-date modifier is defined by the specification
+func (m AsOfDateModifier) Valid() bool {
+	_, ok := asOfDateModifierNames[m]
+	return ok
+}
+
+// Final reports whether the data are final. Interim data may be followed by other data for the same as-of date.
+func (m AsOfDateModifier) Final() bool {
+	return m == AsOfFinalPreviousDay || m == AsOfFinalSameDay
+}
+
+// SameDay reports whether the data are same-day data, as opposed to previous-day data
+func (m AsOfDateModifier) SameDay() bool {
+	return m == AsOfInterimSameDay || m == AsOfFinalSameDay
+}
+
+// Intraday reports whether the data are reported during the day of their as-of date, which are the interim and
+// final same-day data. Previous-day data, even interim, report a day that has ended. Groups without an
+// as-of-date modifier are not intraday.
+func (m AsOfDateModifier) Intraday() bool {
+	return m.SameDay()
+}
+
+func (m AsOfDateModifier) String() string {
+	if name, ok := asOfDateModifierNames[m]; ok {
+		return name
+	}
+	return fmt.Sprintf("AsOfDateModifier(%d)", int64(m))
+}
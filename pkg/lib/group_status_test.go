@@ -0,0 +1,33 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupStatus(t *testing.T) {
+	require.Equal(t, "Correction", GroupStatusCorrection.String())
+	require.Equal(t, "GroupStatus(5)", GroupStatus(5).String())
+	require.False(t, GroupStatus(0).Valid())
+}
+
+func TestAsOfDateModifier(t *testing.T) {
+	require.Equal(t, "InterimSameDay", AsOfInterimSameDay.String())
+	require.True(t, AsOfFinalPreviousDay.Final())
+	require.False(t, AsOfFinalPreviousDay.SameDay())
+	require.True(t, AsOfInterimSameDay.SameDay())
+	require.False(t, AsOfInterimSameDay.Final())
+	require.False(t, AsOfDateModifier(5).Valid())
+
+	// only same-day data are reported during the day
+	require.True(t, AsOfInterimSameDay.Intraday())
+	require.True(t, AsOfFinalSameDay.Intraday())
+	require.False(t, AsOfInterimPreviousDay.Intraday())
+	require.False(t, AsOfFinalPreviousDay.Intraday())
+	require.False(t, AsOfDateModifier(0).Intraday())
+}
@@ -99,3 +99,17 @@ func TestSumAccountControlTotals(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "200", total)
 }
+
+func TestGroupRead_ReadError(t *testing.T) {
+	raw := `02,12345,0004,1,060317,,CAD,/
+03,10200123456,CAD,040,+000000000000,,,045,+000000000000,,/
+49,+00000000000834000,2/
+`
+
+	group := Group{}
+	scan := NewBai2Scanner(failingReader(raw))
+
+	err := group.Read(&scan, false)
+	require.ErrorIs(t, err, errTestRead)
+	require.Len(t, group.Accounts, 1)
+}
@@ -0,0 +1,65 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+// ImportOption configures the BAI2 files converted from statements of other formats, such as the camt.053
+// messages of package iso20022 and the MT940 statements of package swift
+type ImportOption func(*ImportConfig)
+
+// ImportConfig is the sender, receiver and registries of a converted file. Each converter documents how it
+// defaults the sender and receiver that are not configured.
+type ImportConfig struct {
+	Sender   string
+	Receiver string
+
+	registries registries
+}
+
+// NewImportConfig returns the configuration set by the options
+func NewImportConfig(opts ...ImportOption) ImportConfig {
+	var c ImportConfig
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// ImportSender sets the sender of converted files
+func ImportSender(id string) ImportOption {
+	return func(c *ImportConfig) {
+		c.Sender = id
+	}
+}
+
+// ImportReceiver sets the receiver of converted files
+func ImportReceiver(id string) ImportOption {
+	return func(c *ImportConfig) {
+		c.Receiver = id
+	}
+}
+
+// ImportRegistries interprets the type codes, dates and times of converted files with the registries rather
+// than CustomTypeCodes and TimeZones
+func ImportRegistries(opts ...RegistryOption) ImportOption {
+	return func(c *ImportConfig) {
+		c.registries = c.registries.with(opts)
+	}
+}
+
+// TypeCodes returns the registry of the customized type codes of the originators
+func (c ImportConfig) TypeCodes() *TypeCodeRegistry {
+	return c.registries.typeCodeRegistry()
+}
+
+// TimeZones returns the registry of the time zones of the sender and originators
+func (c ImportConfig) TimeZones() *TimeZoneRegistry {
+	return c.registries.timeZoneRegistry()
+}
+
+// RegistryOptions returns the options that give a file, such as one built by FileBuilder.Registries, the
+// registries of the configuration
+func (c ImportConfig) RegistryOptions() []RegistryOption {
+	return []RegistryOption{UseTypeCodes(c.TypeCodes()), UseTimeZones(c.TimeZones())}
+}
@@ -0,0 +1,32 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportConfig(t *testing.T) {
+	config := NewImportConfig()
+	require.Empty(t, config.Sender)
+	require.Empty(t, config.Receiver)
+	require.Same(t, CustomTypeCodes, config.TypeCodes())
+	require.Same(t, TimeZones, config.TimeZones())
+
+	typeCodes := NewTypeCodeRegistry()
+	zones := NewTimeZoneRegistry()
+	config = NewImportConfig(ImportSender("0004"), ImportReceiver("12345"), ImportRegistries(UseTypeCodes(typeCodes)), ImportRegistries(UseTimeZones(zones)))
+	require.Equal(t, "0004", config.Sender)
+	require.Equal(t, "12345", config.Receiver)
+	require.Same(t, typeCodes, config.TypeCodes())
+	require.Same(t, zones, config.TimeZones())
+
+	file := NewBai2()
+	file.UseRegistries(config.RegistryOptions()...)
+	require.Same(t, zones, file.registries.timeZoneRegistry())
+	require.Same(t, typeCodes, file.registries.typeCodeRegistry())
+}
@@ -0,0 +1,276 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// DefaultCurrency is the currency of amounts reported without a currency code in the group or account
+const DefaultCurrency = "USD"
+
+// currencyMinorUnits lists the ISO 4217 currencies that do not have two minor units
+var currencyMinorUnits = map[string]int{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0, "PYG": 0,
+	"RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"CLF": 4, "UYW": 4,
+}
+
+// CurrencyMinorUnits returns the number of implied decimals of amounts in the currency
+func CurrencyMinorUnits(currency string) int {
+	if units, ok := currencyMinorUnits[strings.ToUpper(currency)]; ok {
+		return units
+	}
+	return 2
+}
+
+// Money is an amount in the minor units of its currency. BAI2 amounts are written without a decimal point,
+// the number of implied decimals being given by the currency code.
+type Money struct {
+	Amount   int64
+	Currency string
+}
+
+// NewMoney returns an amount in minor units of the currency, DefaultCurrency being used when it is empty
+func NewMoney(amount int64, currency string) Money {
+	if currency == "" {
+		currency = DefaultCurrency
+	}
+	return Money{Amount: amount, Currency: strings.ToUpper(currency)}
+}
+
+// ParseMoney parses a BAI2 amount field in the currency. An empty field is a zero amount.
+func ParseMoney(amount, currency string) (Money, error) {
+	value, err := parseControlTotal(amount)
+	if err != nil {
+		return Money{}, fmt.Errorf("invalid amount %q", amount)
+	}
+	return NewMoney(value, currency), nil
+}
+
+// Decimal returns the exact value of the amount in major units, e.g. "-1234.56" for -123456 USD
+func (m Money) Decimal() string {
+	units := CurrencyMinorUnits(m.Currency)
+
+	digits := strconv.FormatInt(m.Amount, 10)
+	sign := ""
+	if strings.HasPrefix(digits, "-") {
+		sign, digits = "-", digits[1:]
+	}
+	if units == 0 {
+		return sign + digits
+	}
+
+	if len(digits) <= units {
+		digits = strings.Repeat("0", units-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-units] + "." + digits[len(digits)-units:]
+}
+
+// Rat returns the exact value of the amount in major units
+func (m Money) Rat() *big.Rat {
+	denominator := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(CurrencyMinorUnits(m.Currency))), nil)
+	return new(big.Rat).SetFrac(big.NewInt(m.Amount), denominator)
+}
+
+func (m Money) String() string {
+	return m.Decimal() + " " + m.Currency
+}
+
+// Add returns the sum of two amounts of the same currency
+func (m Money) Add(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, fmt.Errorf("unable to add %s to %s amount", other.Currency, m.Currency)
+	}
+	return Money{Amount: m.Amount + other.Amount, Currency: m.Currency}, nil
+}
+
+// Neg returns the amount with its sign reversed
+func (m Money) Neg() Money {
+	return Money{Amount: -m.Amount, Currency: m.Currency}
+}
+
+type moneyJSON struct {
+	Amount   string `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// MarshalJSON writes the amount as an exact decimal string, e.g. {"amount":"1234.56","currency":"USD"}
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(moneyJSON{Amount: m.Decimal(), Currency: m.Currency})
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var value moneyJSON
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	money, err := ParseDecimal(value.Amount, value.Currency)
+	if err != nil {
+		return err
+	}
+
+	*m = money
+	return nil
+}
+
+// ParseDecimal parses an amount in major units of the currency, e.g. "-1234.56" USD for -123456. Amounts with
+// more decimals than the currency has minor units are invalid, unless the extra decimals are zeros.
+func ParseDecimal(amount, currency string) (Money, error) {
+	currency = NewMoney(0, currency).Currency
+	units := CurrencyMinorUnits(currency)
+
+	value, ok := new(big.Rat).SetString(amount)
+	if !ok {
+		return Money{}, fmt.Errorf("invalid amount %q", amount)
+	}
+	value.Mul(value, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(units)), nil)))
+	if !value.IsInt() || !value.Num().IsInt64() {
+		return Money{}, fmt.Errorf("invalid amount %q for currency %s", amount, currency)
+	}
+
+	return Money{Amount: value.Num().Int64(), Currency: currency}, nil
+}
+
+// Money returns the amount of the summary in the currency of its account
+func (s AccountSummary) Money(currency string) (Money, error) {
+	return ParseMoney(s.Amount, currency)
+}
+
+// Money returns the amount of the detail in the currency of its account
+func (r *Detail) Money(currency string) (Money, error) {
+	return ParseMoney(r.Amount, currency)
+}
+
+type accountJSON struct {
+	AccountNumber       string        `json:"accountNumber"`
+	CurrencyCode        string        `json:"currencyCode,omitempty"`
+	Summaries           []summaryJSON `json:"summaries,omitempty"`
+	AccountControlTotal string        `json:"accountControlTotal"`
+	NumberRecords       int64         `json:"numberRecords"`
+	Details             []detailJSON
+}
+
+type summaryJSON struct {
+	TypeCode  string
+	Amount    string
+	Money     *Money `json:"money,omitempty"`
+	ItemCount int64
+	FundsType fundsTypeJSON
+}
+
+type detailJSON struct {
+	TypeCode                string
+	Amount                  string
+	Money                   *Money `json:"money,omitempty"`
+	FundsType               fundsTypeJSON
+	BankReferenceNumber     string
+	CustomerReferenceNumber string
+	Text                    string
+}
+
+type fundsTypeJSON struct {
+	TypeCode           FundsTypeCode      `json:"type_code,omitempty"`
+	ImmediateAmount    int64              `json:"immediate_amount,omitempty"`
+	ImmediateMoney     *Money             `json:"immediate_money,omitempty"`
+	OneDayAmount       int64              `json:"one_day_amount,omitempty"`
+	OneDayMoney        *Money             `json:"one_day_money,omitempty"`
+	TwoDayAmount       int64              `json:"two_day_amount,omitempty"`
+	TwoDayMoney        *Money             `json:"two_day_money,omitempty"`
+	Date               string             `json:"date,omitempty"`
+	Time               string             `json:"time,omitempty"`
+	DistributionNumber int64              `json:"distribution_number,omitempty"`
+	Distributions      []distributionJSON `json:"distributions,omitempty"`
+}
+
+type distributionJSON struct {
+	Day    int64 `json:"day,omitempty"`
+	Amount int64 `json:"amount,omitempty"`
+	Money  Money `json:"money"`
+}
+
+// MarshalJSON writes the account with the amounts of its summaries, details and funds types as they are written
+// in the file, each followed by its exact value in the currency of the account, e.g. "money":{"amount":"1.234","currency":"BHD"}.
+// Amounts that are not reported, such as the amount of non-monetary information, have no money value.
+func (r Account) MarshalJSON() ([]byte, error) {
+	currency := r.EffectiveCurrency(nil)
+
+	value := accountJSON{
+		AccountNumber:       r.AccountNumber,
+		CurrencyCode:        r.CurrencyCode,
+		AccountControlTotal: r.AccountControlTotal,
+		NumberRecords:       r.NumberRecords,
+	}
+	for _, summary := range r.Summaries {
+		value.Summaries = append(value.Summaries, summaryJSON{
+			TypeCode:  summary.TypeCode,
+			Amount:    summary.Amount,
+			Money:     amountMoney(summary.Amount, currency),
+			ItemCount: summary.ItemCount,
+			FundsType: fundsTypeMoney(summary.FundsType, currency),
+		})
+	}
+	if r.Details != nil {
+		value.Details = make([]detailJSON, 0, len(r.Details))
+	}
+	for _, detail := range r.Details {
+		value.Details = append(value.Details, detailJSON{
+			TypeCode:                detail.TypeCode,
+			Amount:                  detail.Amount,
+			Money:                   amountMoney(detail.Amount, currency),
+			FundsType:               fundsTypeMoney(detail.FundsType, currency),
+			BankReferenceNumber:     detail.BankReferenceNumber,
+			CustomerReferenceNumber: detail.CustomerReferenceNumber,
+			Text:                    detail.Text,
+		})
+	}
+
+	return json.Marshal(value)
+}
+
+// amountMoney returns the value of an amount field, or nil when the amount is not reported or invalid
+func amountMoney(amount, currency string) *Money {
+	if amount == "" {
+		return nil
+	}
+	money, err := ParseMoney(amount, currency)
+	if err != nil {
+		return nil
+	}
+	return &money
+}
+
+// fundsTypeMoney returns the funds type with the value of its availability amounts
+func fundsTypeMoney(f FundsType, currency string) fundsTypeJSON {
+	value := fundsTypeJSON{
+		TypeCode:           f.TypeCode,
+		ImmediateAmount:    f.ImmediateAmount,
+		OneDayAmount:       f.OneDayAmount,
+		TwoDayAmount:       f.TwoDayAmount,
+		Date:               f.Date,
+		Time:               f.Time,
+		DistributionNumber: f.DistributionNumber,
+	}
+
+	if strings.ToUpper(string(f.TypeCode)) == FundsTypeS {
+		immediate, oneDay, twoDay := NewMoney(f.ImmediateAmount, currency), NewMoney(f.OneDayAmount, currency), NewMoney(f.TwoDayAmount, currency)
+		value.ImmediateMoney, value.OneDayMoney, value.TwoDayMoney = &immediate, &oneDay, &twoDay
+	}
+	for _, distribution := range f.Distributions {
+		value.Distributions = append(value.Distributions, distributionJSON{
+			Day:    distribution.Day,
+			Amount: distribution.Amount,
+			Money:  NewMoney(distribution.Amount, currency),
+		})
+	}
+
+	return value
+}
@@ -0,0 +1,256 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoney(t *testing.T) {
+	testCases := []struct {
+		amount   string
+		currency string
+		decimal  string
+	}{
+		{"+00000000000834000", "USD", "8340.00"},
+		{"-500000", "CAD", "-5000.00"},
+		{"5", "usd", "0.05"},
+		{"-5", "", "-0.05"},
+		{"", "USD", "0.00"},
+		{"123456", "JPY", "123456"},
+		{"-123456", "BHD", "-123.456"},
+		{"7", "KWD", "0.007"},
+		{"12345", "CLF", "1.2345"},
+	}
+
+	for _, tc := range testCases {
+		money, err := ParseMoney(tc.amount, tc.currency)
+		require.NoError(t, err)
+		require.Equal(t, tc.decimal, money.Decimal(), tc.amount)
+
+		expected, _ := new(big.Rat).SetString(tc.decimal)
+		require.Equal(t, 0, expected.Cmp(money.Rat()), tc.amount)
+
+		body, err := json.Marshal(money)
+		require.NoError(t, err)
+
+		var decoded Money
+		require.NoError(t, json.Unmarshal(body, &decoded))
+		require.Equal(t, money, decoded)
+	}
+
+	_, err := ParseMoney("12.50", "USD")
+	require.EqualError(t, err, `invalid amount "12.50"`)
+
+	money := NewMoney(-123456, "")
+	require.Equal(t, "-1234.56 USD", money.String())
+
+	body, err := json.Marshal(money)
+	require.NoError(t, err)
+	require.Equal(t, `{"amount":"-1234.56","currency":"USD"}`, string(body))
+
+	var decoded Money
+	require.EqualError(t, json.Unmarshal([]byte(`{"amount":"12.345","currency":"USD"}`), &decoded), `invalid amount "12.345" for currency USD`)
+
+	money, err = ParseDecimal("1234.5", "usd")
+	require.NoError(t, err)
+	require.Equal(t, NewMoney(123450, "USD"), money)
+	money, err = ParseDecimal("-12.3450", "BHD")
+	require.NoError(t, err)
+	require.Equal(t, NewMoney(-12345, "BHD"), money)
+	_, err = ParseDecimal("12,34", "USD")
+	require.EqualError(t, err, `invalid amount "12,34"`)
+
+	sum, err := NewMoney(100, "USD").Add(NewMoney(-250, "USD"))
+	require.NoError(t, err)
+	require.Equal(t, NewMoney(-150, "USD"), sum)
+	require.Equal(t, NewMoney(150, "USD"), sum.Neg())
+
+	_, err = NewMoney(100, "USD").Add(NewMoney(100, "CAD"))
+	require.EqualError(t, err, "unable to add CAD to USD amount")
+}
+
+func TestAccountMoney(t *testing.T) {
+	raw := `01,0004,12345,060321,0829,001,80,1,2/
+02,12345,0004,1,060317,,JPY,/
+03,10200123456,,010,+1000000,,,100,92500,2,/
+16,115,90000,,,,LOCK BOX/
+16,195,2500,,,,/
+16,475,500,,,,/
+49,1185500,6/
+03,10200123457,BHD,015,-1000,,/
+16,475,500,,,,/
+49,-500,3/
+98,1185000,2,11/
+99,1185000,1,13/`
+
+	scan := NewBai2Scanner(strings.NewReader(raw))
+	file := NewBai2()
+	require.NoError(t, file.Read(&scan))
+
+	account := file.Groups[0].Accounts[0]
+	total, err := account.ControlTotal()
+	require.NoError(t, err)
+	require.Equal(t, "1185500 JPY", total.String())
+
+	credits, debits, err := account.DetailTotals()
+	require.NoError(t, err)
+	require.Equal(t, NewMoney(92500, "JPY"), credits)
+	require.Equal(t, NewMoney(500, "JPY"), debits)
+
+	summary, err := account.Summaries[0].Money("JPY")
+	require.NoError(t, err)
+	require.Equal(t, "1000000", summary.Decimal())
+
+	total, err = file.Groups[0].Accounts[1].ControlTotal()
+	require.NoError(t, err)
+	require.Equal(t, "-0.500 BHD", total.String())
+
+	// accounts without a currency in a group without a currency are in the default currency
+	account = Account{AccountNumber: "1", Details: []Detail{{TypeCode: TypeCodeLockboxDeposit, Amount: "125"}}}
+	total, err = account.ControlTotal()
+	require.NoError(t, err)
+	require.Equal(t, "1.25 USD", total.String())
+
+	account.Details[0].Amount = "1.25"
+	_, _, err = account.DetailTotals()
+	require.EqualError(t, err, `invalid amount "1.25" for type code 115`)
+}
+
+func TestControlTotalMoney(t *testing.T) {
+	raw := `01,0004,12345,060321,0829,001,80,1,2/
+02,12345,0004,1,060317,,JPY,/
+03,10200123456,,010,+1000000,,,100,92500,2,/
+16,115,90000,,,,LOCK BOX/
+16,195,2500,,,,/
+16,475,500,,,,/
+49,1185500,6/
+98,1185500,1,8/
+02,12345,0004,1,060317,,BHD,/
+03,10200123457,,015,-1000,,/
+16,475,500,,,,/
+49,-500,3/
+03,10200123458,,015,2000,,/
+49,2000,2/
+98,1500,2,7/
+99,1187000,2,17/`
+
+	scan := NewBai2Scanner(strings.NewReader(raw))
+	file := NewBai2()
+	require.NoError(t, file.Read(&scan))
+
+	total, err := file.Groups[0].ControlTotal()
+	require.NoError(t, err)
+	require.Equal(t, NewMoney(1185500, "JPY"), total)
+	require.Equal(t, "1185500", total.Decimal())
+
+	total, err = file.Groups[1].ControlTotal()
+	require.NoError(t, err)
+	require.Equal(t, "1.500 BHD", total.String())
+
+	_, err = file.ControlTotal()
+	require.EqualError(t, err, "unable to sum amounts of currencies BHD, JPY")
+
+	total, err = file.Groups[0].Accounts[0].DetailAmount()
+	require.NoError(t, err)
+	require.Equal(t, "1184500 JPY", total.String())
+
+	total, err = file.Groups[0].Accounts[0].NetDetailAmount()
+	require.NoError(t, err)
+	require.Equal(t, "1184500 JPY", total.String())
+
+	total, err = file.Groups[1].Accounts[0].NetDetailAmount()
+	require.NoError(t, err)
+	require.Equal(t, "-1.500 BHD", total.String())
+
+	// files with accounts of a single currency have a control total
+	file.Groups = file.Groups[1:]
+	total, err = file.ControlTotal()
+	require.NoError(t, err)
+	require.Equal(t, NewMoney(1500, "BHD"), total)
+
+	total, err = NewBai2().ControlTotal()
+	require.NoError(t, err)
+	require.Equal(t, NewMoney(0, "USD"), total)
+}
+
+func TestAccountMarshalJSON(t *testing.T) {
+	raw := `01,0004,12345,060321,0829,001,80,1,2/
+02,12345,0004,1,060317,,JPY,/
+03,10200123456,,010,+1000000,,,100,92500,,D,2,0,90000,1,2500/
+16,115,90000,,,,LOCK BOX/
+16,890,,,,,NOTE/
+49,1090000,4/
+03,10200123457,BHD,015,-1000,,/
+16,475,1500,S,1000,250,250,,,/
+49,500,3/
+98,1090500,2,9/
+99,1090500,1,11/`
+
+	scan := NewBai2Scanner(strings.NewReader(raw))
+	file := NewBai2()
+	require.NoError(t, file.Read(&scan))
+
+	body, err := json.Marshal(file)
+	require.NoError(t, err)
+
+	var decoded struct {
+		Groups []struct {
+			Accounts []struct {
+				Summaries []struct {
+					Amount    string
+					Money     *Money `json:"money"`
+					FundsType struct {
+						Distributions []struct {
+							Amount int64 `json:"amount"`
+							Money  Money `json:"money"`
+						} `json:"distributions"`
+					}
+				} `json:"summaries"`
+				Details []struct {
+					Amount    string
+					Money     *Money `json:"money"`
+					FundsType struct {
+						ImmediateAmount int64  `json:"immediate_amount"`
+						ImmediateMoney  *Money `json:"immediate_money"`
+						OneDayMoney     *Money `json:"one_day_money"`
+						TwoDayMoney     *Money `json:"two_day_money"`
+					}
+				}
+			}
+		}
+	}
+	require.NoError(t, json.Unmarshal(body, &decoded))
+
+	jpy := decoded.Groups[0].Accounts[0]
+	require.Equal(t, "+1000000", jpy.Summaries[0].Amount)
+	require.Equal(t, &Money{Amount: 1000000, Currency: "JPY"}, jpy.Summaries[0].Money)
+	require.Len(t, jpy.Summaries[1].FundsType.Distributions, 2)
+	require.Equal(t, int64(90000), jpy.Summaries[1].FundsType.Distributions[0].Amount)
+	require.Equal(t, NewMoney(90000, "JPY"), jpy.Summaries[1].FundsType.Distributions[0].Money)
+	require.Equal(t, &Money{Amount: 90000, Currency: "JPY"}, jpy.Details[0].Money)
+	require.Nil(t, jpy.Details[1].Money)
+
+	bhd := decoded.Groups[0].Accounts[1]
+	require.Equal(t, &Money{Amount: -1000, Currency: "BHD"}, bhd.Summaries[0].Money)
+	require.Equal(t, &Money{Amount: 1500, Currency: "BHD"}, bhd.Details[0].Money)
+	require.Equal(t, int64(1000), bhd.Details[0].FundsType.ImmediateAmount)
+	require.Equal(t, &Money{Amount: 1000, Currency: "BHD"}, bhd.Details[0].FundsType.ImmediateMoney)
+	require.Equal(t, &Money{Amount: 250, Currency: "BHD"}, bhd.Details[0].FundsType.TwoDayMoney)
+
+	require.Contains(t, string(body), `"money":{"amount":"1.500","currency":"BHD"}`)
+	require.Contains(t, string(body), `"money":{"amount":"1000000","currency":"JPY"}`)
+
+	// the money values are ignored when reading the JSON back into a file
+	var read Bai2
+	require.NoError(t, json.Unmarshal(body, &read))
+	require.Equal(t, file.Groups[0].Accounts[1].Details[0].FundsType, read.Groups[0].Accounts[1].Details[0].FundsType)
+	require.Equal(t, file.Groups[0].Accounts[0].Summaries, read.Groups[0].Accounts[0].Summaries)
+}
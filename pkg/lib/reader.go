@@ -7,12 +7,14 @@ package lib
 import (
 	"bufio"
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
-	"log"
 	"strings"
 	"unicode"
 
+	"golang.org/x/text/encoding"
+
 	"github.com/moov-io/bai2/pkg/util"
 )
 
@@ -20,12 +22,48 @@ type Bai2Scanner struct {
 	reader      *bufio.Reader
 	currentLine *bytes.Buffer
 	index       int
+	err         error
+
+	continueOnError bool
+	errors          ErrorList
+
+	fixedLength  bool
+	recordLength int64
+
+	encoding       encoding.Encoding
+	detectEncoding bool
+
+	registries registries
 }
 
-func NewBai2Scanner(fd io.Reader) Bai2Scanner {
-	reader := bufio.NewReader(fd)
-	currentLine := new(bytes.Buffer)
-	return Bai2Scanner{reader: reader, currentLine: currentLine}
+// ScannerOption configures optional behavior of a Bai2Scanner
+type ScannerOption func(*Bai2Scanner)
+
+// ContinueOnError configures the scanner to collect recoverable parse errors rather than stopping at the first one.
+// Records that can not be parsed are skipped along with their continuation records, and reading resumes at the next
+// record. Bai2.Read returns the collected errors as an ErrorList alongside the partially read file; callers reading
+// a Group, Account or Detail directly can retrieve them from Errors.
+func ContinueOnError() ScannerOption {
+	return func(b *Bai2Scanner) {
+		b.continueOnError = true
+	}
+}
+
+func NewBai2Scanner(fd io.Reader, opts ...ScannerOption) Bai2Scanner {
+	scan := Bai2Scanner{currentLine: new(bytes.Buffer)}
+	for _, opt := range opts {
+		opt(&scan)
+	}
+
+	if scan.encoding != nil || scan.detectEncoding {
+		fd = newDecodingReader(fd, scan.encoding, scan.detectEncoding)
+	}
+	if scan.fixedLength {
+		fd = newDeblockReader(fd, scan.recordLength)
+	}
+	scan.reader = bufio.NewReader(fd)
+
+	return scan
 }
 
 func (b *Bai2Scanner) GetLineIndex() int {
@@ -36,6 +74,106 @@ func (b *Bai2Scanner) GetLine() string {
 	return strings.TrimSpace(b.currentLine.String())
 }
 
+// Err returns the first non-EOF error that was encountered while reading from the underlying reader.
+// Once an error has been observed, ScanLine returns an empty line on every subsequent call.
+func (b *Bai2Scanner) Err() error {
+	return b.err
+}
+
+// Errors returns the parse errors collected by a scanner configured with ContinueOnError
+func (b *Bai2Scanner) Errors() ErrorList {
+	return b.errors
+}
+
+// collect records a recoverable parse error when the scanner is configured to continue on errors.
+// All other errors are returned as is.
+func (b *Bai2Scanner) collect(err error) error {
+	var pErr *ParseError
+	if !b.continueOnError || !errors.As(err, &pErr) {
+		return err
+	}
+	b.errors = append(b.errors, err)
+	return nil
+}
+
+// skipContinuations reads past the continuation records following the current record. The first line that is not
+// a continuation record is left as the current line.
+func (b *Bai2Scanner) skipContinuations() {
+	for line := b.ScanLine(); strings.HasPrefix(line, util.ContinuationCode); line = b.ScanLine() {
+	}
+}
+
+// readContinuations appends the continuation records following the current record to it, and returns the logical
+// record. The last continuation record that was read is left as the current line.
+func (b *Bai2Scanner) readContinuations(record string) string {
+	for b.continued() {
+		record = joinContinuation(record, b.ScanLine())
+	}
+	return record
+}
+
+// continued reports whether the next record of the underlying reader is a continuation record, without reading it
+func (b *Bai2Scanner) continued() bool {
+	if b.err != nil {
+		return false
+	}
+
+	// skip the white space separating the records
+	for n := 1; ; n++ {
+		bytes, err := b.reader.Peek(n)
+		if err != nil {
+			return false
+		}
+		if !unicode.IsSpace(rune(bytes[n-1])) {
+			bytes, _ = b.reader.Peek(n + 2)
+			return string(bytes[n-1:]) == util.ContinuationCode+","
+		}
+	}
+}
+
+// recordFollows reports whether the rest of the current physical line starts with a new record, without reading it.
+// Records of several types may share a line, e.g. "16,451,3203,Z,,,Visa Billing/  49,-222812,4/".
+func (b *Bai2Scanner) recordFollows() bool {
+	if b.err != nil {
+		return false
+	}
+
+	// skip the blanks separating the records
+	for n := 1; ; n++ {
+		bytes, err := b.reader.Peek(n)
+		if err != nil {
+			return false
+		}
+		if c := bytes[n-1]; c != ' ' && c != '\t' {
+			bytes, _ = b.reader.Peek(n + 2)
+			return isRecordCode(string(bytes[n-1:]))
+		}
+	}
+}
+
+// isRecordCode reports whether the value starts with a BAI2 record code followed by a comma
+func isRecordCode(value string) bool {
+	headerCodes := []string{util.FileHeaderCode, util.GroupHeaderCode, util.AccountIdentifierCode, util.TransactionDetailCode, util.ContinuationCode, util.AccountTrailerCode, util.GroupTrailerCode, util.FileTrailerCode}
+	for _, header := range headerCodes {
+		if value == fmt.Sprintf("%s,", header) {
+			return true
+		}
+	}
+	return false
+}
+
+// joinContinuation appends the fields of a continuation record to the record it continues
+func joinContinuation(record, continuation string) string {
+	fields := strings.TrimPrefix(strings.TrimPrefix(continuation, util.ContinuationCode), ",")
+	return strings.TrimSuffix(record, "/") + "," + fields
+}
+
+// endsEnvelope reports whether the current line is the trailer of an envelope, or one of its continuation records.
+// An envelope that is missing its trailer ends on the first record of the next envelope instead.
+func endsEnvelope(line, trailerCode string) bool {
+	return strings.HasPrefix(line, trailerCode) || strings.HasPrefix(line, util.ContinuationCode)
+}
+
 // ScanLine returns a line from the underlying reader
 // arg[0]: useCurrentLine (if false read a new line)
 func (b *Bai2Scanner) ScanLine(arg ...bool) string {
@@ -49,6 +187,10 @@ func (b *Bai2Scanner) ScanLine(arg ...bool) string {
 		return b.GetLine()
 	}
 
+	if b.err != nil {
+		return ""
+	}
+
 	// Reset the read buffer every time we read a new line.
 	b.currentLine.Reset()
 
@@ -57,7 +199,9 @@ func (b *Bai2Scanner) ScanLine(arg ...bool) string {
 		rune, _, err := b.reader.ReadRune()
 		if err != nil {
 			if err != io.EOF {
-				log.Fatal(err)
+				b.err = err
+				b.currentLine.Reset()
+				return ""
 			}
 			break
 		}
@@ -71,8 +215,9 @@ func (b *Bai2Scanner) ScanLine(arg ...bool) string {
 			// On observing a `/` character, check to see if we have a full record available
 			// for processing -- with exception for transaction or continuation records. For those records,
 			// the record is terminated by a newline followed by record code.
+			// The record still ends when the slash is followed by another record on the same line.
 			line := strings.TrimSpace(b.currentLine.String())
-			if strings.HasPrefix(line, util.TransactionDetailCode) || strings.HasPrefix(line, util.ContinuationCode) {
+			if (strings.HasPrefix(line, util.TransactionDetailCode) || strings.HasPrefix(line, util.ContinuationCode)) && !b.recordFollows() {
 				continue
 			}
 			goto fullLine
@@ -107,23 +252,15 @@ func (b *Bai2Scanner) ScanLine(arg ...bool) string {
 		// observed, continue parsing lines until a distinct record is observed.
 		bytes, err := b.reader.Peek(3)
 		if err != nil && err != io.EOF {
-			log.Fatal(err)
+			b.err = err
+			b.currentLine.Reset()
+			return ""
 		}
 
 		// If the next three bytes are any of the defined BAI2 record codes (followed by a comma), we consider the next line
 		// as a new record and process the current line up to this point.
-		nextThreeBytes := string(bytes)
-		headerCodes := []string{util.FileHeaderCode, util.GroupHeaderCode, util.AccountIdentifierCode, util.TransactionDetailCode, util.ContinuationCode, util.AccountTrailerCode, util.GroupTrailerCode, util.FileTrailerCode}
-		nextLineHasNewRecord := false
-		for _, header := range headerCodes {
-			if nextThreeBytes == fmt.Sprintf("%s,", header) {
-				b.currentLine.WriteString("/")
-				nextLineHasNewRecord = true
-				break
-			}
-		}
-
-		if nextLineHasNewRecord {
+		if isRecordCode(string(bytes)) {
+			b.currentLine.WriteString("/")
 			break
 		}
 
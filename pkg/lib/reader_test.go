@@ -0,0 +1,62 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/require"
+)
+
+var errTestRead = errors.New("connection reset by peer")
+
+// failingReader returns the provided data followed by errTestRead.
+func failingReader(data string) io.Reader {
+	return io.MultiReader(strings.NewReader(data), iotest.ErrReader(errTestRead))
+}
+
+func TestScanLine_ReadError(t *testing.T) {
+	scan := NewBai2Scanner(failingReader("01,0004,12345,060321,0829,001,80,1,2/\n02,12345,0004"))
+
+	require.Equal(t, "01,0004,12345,060321,0829,001,80,1,2/", scan.ScanLine())
+	require.NoError(t, scan.Err())
+
+	require.Equal(t, "", scan.ScanLine())
+	require.ErrorIs(t, scan.Err(), errTestRead)
+
+	// The scanner stays in its error state
+	require.Equal(t, "", scan.ScanLine())
+	require.ErrorIs(t, scan.Err(), errTestRead)
+	require.Equal(t, 1, scan.GetLineIndex())
+}
+
+func TestScanLine_PeekError(t *testing.T) {
+	scan := NewBai2Scanner(failingReader("16,266,1912,,GI2118700002010,20210706MMQFMPU8000001,Outgoing Wire Return,-\n"))
+
+	require.Equal(t, "", scan.ScanLine())
+	require.ErrorIs(t, scan.Err(), errTestRead)
+}
+
+func TestScanLine_EOF(t *testing.T) {
+	scan := NewBai2Scanner(strings.NewReader("99,+00000000001280000,1,27/"))
+
+	require.Equal(t, "99,+00000000001280000,1,27/", scan.ScanLine())
+	require.Equal(t, "", scan.ScanLine())
+	require.NoError(t, scan.Err())
+}
+
+func TestScanLine_RecordsSharingLine(t *testing.T) {
+	scan := NewBai2Scanner(strings.NewReader("16,451,27500,Z,,,Visa Billing/ 16,451,3203,Z,,,Visa/Billing/  49,-30703,4/\n"))
+
+	require.Equal(t, "16,451,27500,Z,,,Visa Billing/", scan.ScanLine())
+	require.Equal(t, "16,451,3203,Z,,,Visa/Billing/", scan.ScanLine())
+	require.Equal(t, "49,-30703,4/", scan.ScanLine())
+	require.Equal(t, "", scan.ScanLine())
+	require.NoError(t, scan.Err())
+}
@@ -0,0 +1,231 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+import (
+	"fmt"
+	"sort"
+)
+
+/*
+
+RECONCILIATION
+
+The group status tells the receiver how to process the data of every account of a group with the data
+already on file for the account on the as-of date of the group:
+
+	Update      status and summaries replace those with the same type code, other status and summaries
+	            remain unchanged, and transaction details are added unless they are already on file
+	            with the same type code, amount and bank and customer reference numbers
+	Deletion    all data on file for the account on the as-of date are removed
+	Correction  all data on file for the account on the as-of date are replaced
+	Test Only   data on file are not affected
+
+*/
+
+// AccountKey identifies the data on file for an account of an originator on an as-of date
+type AccountKey struct {
+	Originator    string
+	AccountNumber string
+	AsOfDate      string
+}
+
+// Reconciler holds the data on file for every account, and applies the groups of the files received from
+// the banks in the order they are received. It is not safe for concurrent use.
+type Reconciler struct {
+	accounts map[AccountKey]Account
+}
+
+// NewReconciler returns a reconciler without data on file
+func NewReconciler() *Reconciler {
+	return &Reconciler{
+		accounts: make(map[AccountKey]Account),
+	}
+}
+
+// Apply applies every group of the file. When a group cannot be applied, none of the groups of the file are,
+// and the data on file are left unchanged.
+func (r *Reconciler) Apply(file *Bai2) error {
+	changes := make(map[AccountKey]*Account)
+	for i := range file.Groups {
+		if err := r.stage(changes, &file.Groups[i]); err != nil {
+			return fmt.Errorf("%v in group %d", err, i+1)
+		}
+	}
+	r.commit(changes)
+	return nil
+}
+
+// ApplyGroup applies the accounts of the group to the data on file according to the status of the group.
+// The trailers of the accounts on file are recomputed after every change. When an account cannot be applied,
+// the data on file are left unchanged.
+func (r *Reconciler) ApplyGroup(group *Group) error {
+	changes := make(map[AccountKey]*Account)
+	if err := r.stage(changes, group); err != nil {
+		return err
+	}
+	r.commit(changes)
+	return nil
+}
+
+// stage records the accounts of the group as changes to the data on file, or to the changes already staged.
+// A deleted account is staged as nil.
+func (r *Reconciler) stage(changes map[AccountKey]*Account, group *Group) error {
+	if !group.GroupStatus.Valid() {
+		return fmt.Errorf("unable to reconcile group status %d", int64(group.GroupStatus))
+	}
+	if group.GroupStatus == GroupStatusTestOnly {
+		return nil
+	}
+
+	for i := range group.Accounts {
+		account := copyAccount(&group.Accounts[i])
+		account.originator = group.Originator
+		account.groupCurrency = group.CurrencyCode
+		account.registries = group.registries
+
+		key := AccountKey{Originator: group.Originator, AccountNumber: account.AccountNumber, AsOfDate: group.AsOfDate}
+
+		switch group.GroupStatus {
+		case GroupStatusDeletion:
+			changes[key] = nil
+			continue
+		case GroupStatusUpdate:
+			if existing, ok := r.staged(changes, key); ok {
+				account = mergeAccount(copyAccount(&existing), account)
+			}
+		}
+
+		account.resolveCurrencies()
+		if err := account.Finalize(); err != nil {
+			return err
+		}
+		changes[key] = &account
+	}
+
+	return nil
+}
+
+// staged returns the account as changed by the staged changes, or else as on file
+func (r *Reconciler) staged(changes map[AccountKey]*Account, key AccountKey) (Account, bool) {
+	if account, ok := changes[key]; ok {
+		if account == nil {
+			return Account{}, false
+		}
+		return *account, true
+	}
+	account, ok := r.accounts[key]
+	return account, ok
+}
+
+// commit applies the staged changes to the data on file
+func (r *Reconciler) commit(changes map[AccountKey]*Account) {
+	for key, account := range changes {
+		if account == nil {
+			delete(r.accounts, key)
+		} else {
+			r.accounts[key] = *account
+		}
+	}
+}
+
+// Account returns the data on file for the account
+func (r *Reconciler) Account(key AccountKey) (Account, bool) {
+	account, ok := r.accounts[key]
+	if !ok {
+		return Account{}, false
+	}
+	return copyAccount(&account), true
+}
+
+// Keys returns the keys of the accounts with data on file, ordered by originator, as-of date and account number
+func (r *Reconciler) Keys() []AccountKey {
+	keys := make([]AccountKey, 0, len(r.accounts))
+	for key := range r.accounts {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Originator != keys[j].Originator {
+			return keys[i].Originator < keys[j].Originator
+		}
+		if keys[i].AsOfDate != keys[j].AsOfDate {
+			return keys[i].AsOfDate < keys[j].AsOfDate
+		}
+		return keys[i].AccountNumber < keys[j].AccountNumber
+	})
+	return keys
+}
+
+// copyAccount returns a copy of the account that does not share its summaries and details
+func copyAccount(account *Account) Account {
+	c := *account
+	c.Summaries = append([]AccountSummary(nil), account.Summaries...)
+	c.Details = append([]Detail(nil), account.Details...)
+	return c
+}
+
+// mergeAccount applies an update to the account on file
+func mergeAccount(existing, update Account) Account {
+	if update.CurrencyCode != "" {
+		existing.CurrencyCode = update.CurrencyCode
+	}
+	existing.groupCurrency = update.groupCurrency
+
+	for _, summary := range update.Summaries {
+		if summary.TypeCode == "" {
+			continue
+		}
+
+		replaced := false
+		for i := range existing.Summaries {
+			if existing.Summaries[i].TypeCode == summary.TypeCode {
+				existing.Summaries[i] = summary
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			existing.Summaries = append(existing.Summaries, summary)
+		}
+	}
+
+	// details sent again by the update replace those on file, every detail on file matching a single detail
+	// of the update, so that identical details without reference numbers are not merged together
+	matched := make([]bool, len(existing.Details))
+	for _, detail := range update.Details {
+		replaced := false
+		for i := range existing.Details {
+			if !matched[i] && sameDetail(&existing.Details[i], &detail) {
+				existing.Details[i] = detail
+				matched[i] = true
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			existing.Details = append(existing.Details, detail)
+		}
+	}
+
+	return existing
+}
+
+// sameDetail reports whether two transaction details report the same transaction
+func sameDetail(a, b *Detail) bool {
+	return a.TypeCode == b.TypeCode &&
+		a.BankReferenceNumber == b.BankReferenceNumber &&
+		a.CustomerReferenceNumber == b.CustomerReferenceNumber &&
+		amountsEqual(a.Amount, b.Amount)
+}
+
+// amountsEqual reports whether two amount fields have the same value, e.g. "+100" and "100"
+func amountsEqual(a, b string) bool {
+	x, errX := parseControlTotal(a)
+	y, errY := parseControlTotal(b)
+	if errX != nil || errY != nil {
+		return a == b
+	}
+	return x == y
+}
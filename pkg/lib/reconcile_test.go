@@ -0,0 +1,129 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func readReconcileFile(t *testing.T, raw string) *Bai2 {
+	t.Helper()
+
+	scan := NewBai2Scanner(strings.NewReader(raw))
+	file := NewBai2()
+	require.NoError(t, file.Read(&scan))
+	return file
+}
+
+func TestReconciler(t *testing.T) {
+	reconciler := NewReconciler()
+
+	require.NoError(t, reconciler.Apply(readReconcileFile(t, `01,0004,12345,060321,0829,001,80,1,2/
+02,12345,0004,1,060317,,,3/
+03,10200123456,,010,100000,,,015,90000,,/
+16,115,10000,,,,/
+49,200000,3/
+03,10200123457,,010,5000,,/
+49,5000,2/
+98,205000,2,7/
+99,205000,1,9/`)))
+
+	// update of the closing ledger with an additional detail and a detail already on file, correction and
+	// deletion in a later file
+	require.NoError(t, reconciler.Apply(readReconcileFile(t, `01,0004,12345,060321,1200,002,80,1,2/
+02,12345,0004,1,060317,,,4/
+03,10200123456,,015,95000,,,045,80000,,/
+16,475,5000,,,,/
+16,115,+10000,,,,/
+49,190000,4/
+98,190000,1,6/
+02,12345,0004,2,060317,,,4/
+03,10200123457,,,,,/
+49,0,2/
+98,0,1,4/
+02,12345,0004,4,060317,,,4/
+03,10200123456,,010,1,,/
+49,1,2/
+98,1,1,4/
+99,190001,3,16/`)))
+
+	key := AccountKey{Originator: "0004", AccountNumber: "10200123456", AsOfDate: "060317"}
+	require.Equal(t, []AccountKey{key}, reconciler.Keys())
+
+	account, ok := reconciler.Account(key)
+	require.True(t, ok)
+	require.Equal(t, []AccountSummary{
+		{TypeCode: TypeCodeOpeningLedger, Amount: "100000"},
+		{TypeCode: TypeCodeClosingLedger, Amount: "95000"},
+		{TypeCode: TypeCodeClosingAvailable, Amount: "80000"},
+	}, account.Summaries)
+	require.Len(t, account.Details, 2)
+	require.Equal(t, "+10000", account.Details[0].Amount)
+	require.Equal(t, "290000", account.AccountControlTotal)
+	require.Equal(t, int64(4), account.NumberRecords)
+	require.Equal(t, "USD", account.Details[1].Currency())
+
+	_, ok = reconciler.Account(AccountKey{Originator: "0004", AccountNumber: "10200123457", AsOfDate: "060317"})
+	require.False(t, ok)
+
+	// a correction replaces all the data on file
+	group := Group{
+		Originator:  "0004",
+		GroupStatus: GroupStatusCorrection,
+		AsOfDate:    "060317",
+		Accounts:    []Account{{AccountNumber: "10200123456", Summaries: []AccountSummary{{TypeCode: TypeCodeClosingLedger, Amount: "1000"}}}},
+	}
+	require.NoError(t, reconciler.ApplyGroup(&group))
+
+	account, ok = reconciler.Account(key)
+	require.True(t, ok)
+	require.Len(t, account.Summaries, 1)
+	require.Empty(t, account.Details)
+	require.Equal(t, "1000", account.AccountControlTotal)
+
+	// accounts on file are not shared with the groups applied
+	group.Accounts[0].Summaries[0].Amount = "2000"
+	account, _ = reconciler.Account(key)
+	require.Equal(t, "1000", account.Summaries[0].Amount)
+
+	group.GroupStatus = 0
+	require.EqualError(t, reconciler.ApplyGroup(&group), "unable to reconcile group status 0")
+
+	// the data on file are unchanged when a group of the file cannot be applied
+	file := readReconcileFile(t, `01,0004,12345,060321,1500,003,80,1,2/
+02,12345,0004,3,060317,,,4/
+03,10200123456,,015,5,,/
+49,5,2/
+98,5,1,4/
+02,12345,0004,3,060318,,,4/
+03,10200123456,,015,7,,/
+16,115,10,,,,/
+49,17,3/
+98,17,1,5/
+99,22,2,11/`)
+	file.Groups[1].Accounts[0].Details[0].Amount = "1.5"
+	require.ErrorContains(t, reconciler.Apply(file), "in group 2")
+	require.Equal(t, []AccountKey{key}, reconciler.Keys())
+	account, _ = reconciler.Account(key)
+	require.Equal(t, []AccountSummary{{TypeCode: TypeCodeClosingLedger, Amount: "1000"}}, account.Summaries)
+
+	// as they are when an account of the group cannot be applied
+	group = Group{
+		Originator:  "0004",
+		GroupStatus: GroupStatusUpdate,
+		AsOfDate:    "060317",
+		Accounts: []Account{
+			{AccountNumber: "10200123456", Summaries: []AccountSummary{{TypeCode: TypeCodeClosingLedger, Amount: "5"}}},
+			{AccountNumber: "10200123457", Details: []Detail{{TypeCode: TypeCodeLockboxDeposit, Amount: "1.5"}}},
+		},
+	}
+	require.Error(t, reconciler.ApplyGroup(&group))
+	account, _ = reconciler.Account(key)
+	require.Equal(t, "1000", account.Summaries[0].Amount)
+	require.Equal(t, []AccountKey{key}, reconciler.Keys())
+}
@@ -28,27 +28,36 @@ type accountIdentifier struct {
 	CurrencyCode  string
 
 	Summaries []AccountSummary
+
+	// originator of the group, which defines the meaning of customized type codes
+	originator string
+	// registry of the customized type codes, defaulting to CustomTypeCodes
+	typeCodes *TypeCodeRegistry
+}
+
+func (r *accountIdentifier) typeCodeRegistry() *TypeCodeRegistry {
+	return registries{typeCodes: r.typeCodes}.typeCodeRegistry()
 }
 
 func (r *accountIdentifier) validate() error {
 
 	if r.AccountNumber == "" {
-		return fmt.Errorf(fmt.Sprintf(aiValidateErrorFmt, "AccountNumber"))
+		return newValidationError(aiValidateErrorFmt, "AccountNumber")
 	}
 
 	if r.CurrencyCode != "" && !util.ValidateCurrencyCode(r.CurrencyCode) {
-		return fmt.Errorf(fmt.Sprintf(aiValidateErrorFmt, "CurrencyCode"))
+		return newValidationError(aiValidateErrorFmt, "CurrencyCode")
 	}
 
 	for _, summary := range r.Summaries {
 		if summary.Amount != "" && !util.ValidateAmount(summary.Amount) {
-			return fmt.Errorf(fmt.Sprintf(aiValidateErrorFmt, "Amount"))
+			return newValidationError(aiValidateErrorFmt, "Amount")
 		}
-		if summary.TypeCode != "" && !util.ValidateTypeCode(summary.TypeCode) {
-			return fmt.Errorf(fmt.Sprintf(aiValidateErrorFmt, "TypeCode"))
+		if summary.TypeCode != "" && !r.typeCodeRegistry().allows(r.originator, summary.TypeCode, util.AccountIdentifierCode) {
+			return newValidationError(aiValidateErrorFmt, "TypeCode")
 		}
 		if summary.FundsType.Validate() != nil {
-			return fmt.Errorf(fmt.Sprintf(aiValidateErrorFmt, "FundsType"))
+			return newValidationError(aiValidateErrorFmt, "FundsType")
 		}
 	}
 
@@ -63,27 +72,27 @@ func (r *accountIdentifier) parse(data string) (int, error) {
 
 	length := util.GetSize(data)
 	if length < 3 {
-		return 0, fmt.Errorf(fmt.Sprintf(aiParseErrorFmt, "record"))
+		return 0, newFieldError(aiParseErrorFmt, "record", read)
 	} else {
 		line = data[:length]
 	}
 
 	// RecordCode
 	if util.AccountIdentifierCode != data[:2] {
-		return 0, fmt.Errorf(fmt.Sprintf(aiParseErrorFmt, "RecordCode"))
+		return 0, newFieldError(aiParseErrorFmt, "RecordCode", read)
 	}
 	read += 3
 
 	// AccountNumber
 	if r.AccountNumber, size, err = util.ReadField(line, read); err != nil {
-		return 0, fmt.Errorf(fmt.Sprintf(aiParseErrorFmt, "AccountNumber"))
+		return 0, newFieldError(aiParseErrorFmt, "AccountNumber", read)
 	} else {
 		read += size
 	}
 
 	// CurrencyCode
 	if r.CurrencyCode, size, err = util.ReadField(line, read); err != nil {
-		return 0, fmt.Errorf(fmt.Sprintf(aiParseErrorFmt, "CurrencyCode"))
+		return 0, newFieldError(aiParseErrorFmt, "CurrencyCode", read)
 	} else {
 		read += size
 	}
@@ -94,27 +103,27 @@ func (r *accountIdentifier) parse(data string) (int, error) {
 
 		// TypeCode
 		if summary.TypeCode, size, err = util.ReadField(line, read); err != nil {
-			return 0, fmt.Errorf(fmt.Sprintf(aiParseErrorFmt, "TypeCode"))
+			return 0, newFieldError(aiParseErrorFmt, "TypeCode", read)
 		} else {
 			read += size
 		}
 
 		// Amount
 		if summary.Amount, size, err = util.ReadField(line, read); err != nil {
-			return 0, fmt.Errorf(fmt.Sprintf(aiParseErrorFmt, "Amount"))
+			return 0, newFieldError(aiParseErrorFmt, "Amount", read)
 		} else {
 			read += size
 		}
 
 		// ItemCount
 		if summary.ItemCount, size, err = util.ReadFieldAsInt(line, read); err != nil {
-			return 0, fmt.Errorf(fmt.Sprintf(aiParseErrorFmt, "ItemCount"))
+			return 0, newFieldError(aiParseErrorFmt, "ItemCount", read)
 		} else {
 			read += size
 		}
 
 		if size, err = summary.FundsType.parse(line[read:]); err != nil {
-			return 0, fmt.Errorf(fmt.Sprintf(aiParseErrorFmt, "FundsType"))
+			return 0, newFieldError(aiParseErrorFmt, "FundsType", read)
 		} else {
 			read += size
 		}
@@ -23,7 +23,7 @@ type accountTrailer struct {
 
 func (h *accountTrailer) validate() error {
 	if h.AccountControlTotal != "" && !util.ValidateAmount(h.AccountControlTotal) {
-		return fmt.Errorf(fmt.Sprintf(atValidateErrorFmt, "Amount"))
+		return newValidationError(atValidateErrorFmt, "Amount")
 	}
 
 	return nil
@@ -37,27 +37,27 @@ func (h *accountTrailer) parse(data string) (int, error) {
 
 	length := util.GetSize(data)
 	if length < 3 {
-		return 0, fmt.Errorf(fmt.Sprintf(atParseErrorFmt, "record"))
+		return 0, newFieldError(atParseErrorFmt, "record", read)
 	} else {
 		line = data[:length]
 	}
 
 	// RecordCode
 	if util.AccountTrailerCode != data[:2] {
-		return 0, fmt.Errorf(fmt.Sprintf(fhParseErrorFmt, "RecordCode"))
+		return 0, newFieldError(atParseErrorFmt, "RecordCode", read)
 	}
 	read += 3
 
 	// AccountControlTotal
 	if h.AccountControlTotal, size, err = util.ReadField(line, read); err != nil {
-		return 0, fmt.Errorf(fmt.Sprintf(atParseErrorFmt, "AccountControlTotal"))
+		return 0, newFieldError(atParseErrorFmt, "AccountControlTotal", read)
 	} else {
 		read += size
 	}
 
 	// NumberRecords
 	if h.NumberRecords, size, err = util.ReadFieldAsInt(line, read); err != nil {
-		return 0, fmt.Errorf(fmt.Sprintf(atParseErrorFmt, "NumberRecords"))
+		return 0, newFieldError(atParseErrorFmt, "NumberRecords", read)
 	} else {
 		read += size
 	}
@@ -69,12 +69,22 @@ func (h *accountTrailer) parse(data string) (int, error) {
 	return read, nil
 }
 
-func (h *accountTrailer) string() string {
-	var buf bytes.Buffer
+func (h *accountTrailer) string(opts ...int64) string {
+
+	var maxLen int64
+	if len(opts) > 0 {
+		maxLen = opts[0]
+	}
+
+	var total, buf bytes.Buffer
 
 	buf.WriteString(fmt.Sprintf("%s,", util.AccountTrailerCode))
-	buf.WriteString(fmt.Sprintf("%s,", h.AccountControlTotal))
-	buf.WriteString(fmt.Sprintf("%d/", h.NumberRecords))
+	util.WriteBuffer(&total, &buf, h.AccountControlTotal, maxLen)
+	buf.WriteString(",")
+	util.WriteBuffer(&total, &buf, fmt.Sprintf("%d", h.NumberRecords), maxLen)
+	buf.WriteString("/")
+
+	total.WriteString(buf.String())
 
-	return buf.String()
+	return total.String()
 }
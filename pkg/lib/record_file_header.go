@@ -29,26 +29,26 @@ type fileHeader struct {
 
 func (h *fileHeader) validate() error {
 	if h.Sender == "" {
-		return fmt.Errorf(fmt.Sprintf(fhValidateErrorFmt, "Sender"))
+		return newValidationError(fhValidateErrorFmt, "Sender")
 	}
 	if h.Receiver == "" {
-		return fmt.Errorf(fmt.Sprintf(fhValidateErrorFmt, "Receiver"))
+		return newValidationError(fhValidateErrorFmt, "Receiver")
 	}
 	if h.FileCreatedDate == "" {
-		return fmt.Errorf(fmt.Sprintf(fhValidateErrorFmt, "FileCreatedDate"))
+		return newValidationError(fhValidateErrorFmt, "FileCreatedDate")
 	} else if !util.ValidateDate(h.FileCreatedDate) {
-		return fmt.Errorf(fmt.Sprintf(fhValidateErrorFmt, "FileCreatedDate"))
+		return newValidationError(fhValidateErrorFmt, "FileCreatedDate")
 	}
 	if h.FileCreatedTime == "" {
-		return fmt.Errorf(fmt.Sprintf(fhValidateErrorFmt, "FileCreatedTime"))
+		return newValidationError(fhValidateErrorFmt, "FileCreatedTime")
 	} else if !util.ValidateTime(h.FileCreatedTime) {
-		return fmt.Errorf(fmt.Sprintf(fhValidateErrorFmt, "FileCreatedTime"))
+		return newValidationError(fhValidateErrorFmt, "FileCreatedTime")
 	}
 	if h.FileIdNumber == "" {
-		return fmt.Errorf(fmt.Sprintf(fhValidateErrorFmt, "FileIdNumber"))
+		return newValidationError(fhValidateErrorFmt, "FileIdNumber")
 	}
 	if h.VersionNumber != 2 {
-		return fmt.Errorf(fmt.Sprintf(fhValidateErrorFmt, "VersionNumber"))
+		return newValidationError(fhValidateErrorFmt, "VersionNumber")
 	}
 
 	return nil
@@ -61,69 +61,69 @@ func (h *fileHeader) parse(data string) (int, error) {
 	var size, read int
 
 	if length := util.GetSize(data); length < 3 {
-		return 0, fmt.Errorf(fmt.Sprintf(fhParseErrorFmt, "record"))
+		return 0, newFieldError(fhParseErrorFmt, "record", read)
 	} else {
 		line = data[:length]
 	}
 
 	// RecordCode
 	if util.FileHeaderCode != line[:2] {
-		return 0, fmt.Errorf(fmt.Sprintf(fhParseErrorFmt, "RecordCode"))
+		return 0, newFieldError(fhParseErrorFmt, "RecordCode", read)
 	}
 	read += 3
 
 	// Sender
 	if h.Sender, size, err = util.ReadField(line, read); err != nil {
-		return 0, fmt.Errorf(fmt.Sprintf(fhParseErrorFmt, "Sender"))
+		return 0, newFieldError(fhParseErrorFmt, "Sender", read)
 	} else {
 		read += size
 	}
 
 	// Receiver
 	if h.Receiver, size, err = util.ReadField(line, read); err != nil {
-		return 0, fmt.Errorf(fmt.Sprintf(fhParseErrorFmt, "Receiver"))
+		return 0, newFieldError(fhParseErrorFmt, "Receiver", read)
 	} else {
 		read += size
 	}
 
 	// FileCreatedDate
 	if h.FileCreatedDate, size, err = util.ReadField(line, read); err != nil {
-		return 0, fmt.Errorf(fmt.Sprintf(fhParseErrorFmt, "FileCreatedDate"))
+		return 0, newFieldError(fhParseErrorFmt, "FileCreatedDate", read)
 	} else {
 		read += size
 	}
 
 	// FileCreatedTime
 	if h.FileCreatedTime, size, err = util.ReadField(line, read); err != nil {
-		return 0, fmt.Errorf(fmt.Sprintf(fhParseErrorFmt, "FileCreatedTime"))
+		return 0, newFieldError(fhParseErrorFmt, "FileCreatedTime", read)
 	} else {
 		read += size
 	}
 
 	// FileIdNumber
 	if h.FileIdNumber, size, err = util.ReadField(line, read); err != nil {
-		return 0, fmt.Errorf(fmt.Sprintf(fhParseErrorFmt, "FileIdNumber"))
+		return 0, newFieldError(fhParseErrorFmt, "FileIdNumber", read)
 	} else {
 		read += size
 	}
 
 	// PhysicalRecordLength
 	if h.PhysicalRecordLength, size, err = util.ReadFieldAsInt(line, read); err != nil {
-		return 0, fmt.Errorf(fmt.Sprintf(fhParseErrorFmt, "PhysicalRecordLength"))
+		return 0, newFieldError(fhParseErrorFmt, "PhysicalRecordLength", read)
 	} else {
 		read += size
 	}
 
 	// BlockSize
 	if h.BlockSize, size, err = util.ReadFieldAsInt(line, read); err != nil {
-		return 0, fmt.Errorf(fmt.Sprintf(fhParseErrorFmt, "BlockSize"))
+		return 0, newFieldError(fhParseErrorFmt, "BlockSize", read)
 	} else {
 		read += size
 	}
 
 	// VersionNumber
 	if h.VersionNumber, size, err = util.ReadFieldAsInt(line, read); err != nil {
-		return 0, fmt.Errorf(fmt.Sprintf(fhParseErrorFmt, "VersionNumber"))
+		return 0, newFieldError(fhParseErrorFmt, "VersionNumber", read)
 	} else {
 		read += size
 	}
@@ -135,26 +135,32 @@ func (h *fileHeader) parse(data string) (int, error) {
 	return read, nil
 }
 
-func (h *fileHeader) string() string {
-	var buf bytes.Buffer
+func (h *fileHeader) string(opts ...int64) string {
+
+	var maxLen int64
+	if len(opts) > 0 {
+		maxLen = opts[0]
+	}
+
+	var total, buf bytes.Buffer
 
 	buf.WriteString(fmt.Sprintf("%s,", util.FileHeaderCode))
-	buf.WriteString(fmt.Sprintf("%s,", h.Sender))
-	buf.WriteString(fmt.Sprintf("%s,", h.Receiver))
-	buf.WriteString(fmt.Sprintf("%s,", h.FileCreatedDate))
-	buf.WriteString(fmt.Sprintf("%s,", h.FileCreatedTime))
-	buf.WriteString(fmt.Sprintf("%s,", h.FileIdNumber))
-	if h.PhysicalRecordLength > 0 {
-		buf.WriteString(fmt.Sprintf("%d,", h.PhysicalRecordLength))
-	} else {
+	for _, field := range []string{h.Sender, h.Receiver, h.FileCreatedDate, h.FileCreatedTime, h.FileIdNumber} {
+		util.WriteBuffer(&total, &buf, field, maxLen)
 		buf.WriteString(",")
 	}
+	if h.PhysicalRecordLength > 0 {
+		util.WriteBuffer(&total, &buf, fmt.Sprintf("%d", h.PhysicalRecordLength), maxLen)
+	}
+	buf.WriteString(",")
 	if h.BlockSize > 0 {
-		buf.WriteString(fmt.Sprintf("%d,", h.BlockSize))
-	} else {
-		buf.WriteString(",")
+		util.WriteBuffer(&total, &buf, fmt.Sprintf("%d", h.BlockSize), maxLen)
 	}
-	buf.WriteString(fmt.Sprintf("%d/", h.VersionNumber))
+	buf.WriteString(",")
+	util.WriteBuffer(&total, &buf, fmt.Sprintf("%d", h.VersionNumber), maxLen)
+	buf.WriteString("/")
+
+	total.WriteString(buf.String())
 
-	return buf.String()
+	return total.String()
 }
@@ -24,7 +24,7 @@ type fileTrailer struct {
 
 func (h *fileTrailer) validate() error {
 	if h.FileControlTotal != "" && !util.ValidateAmount(h.FileControlTotal) {
-		return fmt.Errorf(fmt.Sprintf(ftValidateErrorFmt, "FileControlTotal"))
+		return newValidationError(ftValidateErrorFmt, "FileControlTotal")
 	}
 
 	return nil
@@ -37,34 +37,34 @@ func (h *fileTrailer) parse(data string) (int, error) {
 	var size, read int
 
 	if length := util.GetSize(data); length < 3 {
-		return 0, fmt.Errorf(fmt.Sprintf(ftParseErrorFmt, "record"))
+		return 0, newFieldError(ftParseErrorFmt, "record", read)
 	} else {
 		line = data[:length]
 	}
 
 	// RecordCode
 	if util.FileTrailerCode != line[:2] {
-		return 0, fmt.Errorf(fmt.Sprintf(fhParseErrorFmt, "RecordCode"))
+		return 0, newFieldError(ftParseErrorFmt, "RecordCode", read)
 	}
 	read += 3
 
-	// GroupControlTotal
+	// FileControlTotal
 	if h.FileControlTotal, size, err = util.ReadField(line, read); err != nil {
-		return 0, fmt.Errorf(fmt.Sprintf(ftParseErrorFmt, "GroupControlTotal"))
+		return 0, newFieldError(ftParseErrorFmt, "FileControlTotal", read)
 	} else {
 		read += size
 	}
 
 	// NumberOfGroups
 	if h.NumberOfGroups, size, err = util.ReadFieldAsInt(line, read); err != nil {
-		return 0, fmt.Errorf(fmt.Sprintf(ftParseErrorFmt, "NumberOfGroups"))
+		return 0, newFieldError(ftParseErrorFmt, "NumberOfGroups", read)
 	} else {
 		read += size
 	}
 
 	// NumberOfRecords
 	if h.NumberOfRecords, size, err = util.ReadFieldAsInt(line, read); err != nil {
-		return 0, fmt.Errorf(fmt.Sprintf(ftParseErrorFmt, "NumberOfRecords"))
+		return 0, newFieldError(ftParseErrorFmt, "NumberOfRecords", read)
 	} else {
 		read += size
 	}
@@ -76,13 +76,24 @@ func (h *fileTrailer) parse(data string) (int, error) {
 	return read, nil
 }
 
-func (h *fileTrailer) string() string {
-	var buf bytes.Buffer
+func (h *fileTrailer) string(opts ...int64) string {
+
+	var maxLen int64
+	if len(opts) > 0 {
+		maxLen = opts[0]
+	}
+
+	var total, buf bytes.Buffer
 
 	buf.WriteString(fmt.Sprintf("%s,", util.FileTrailerCode))
-	buf.WriteString(fmt.Sprintf("%s,", h.FileControlTotal))
-	buf.WriteString(fmt.Sprintf("%d,", h.NumberOfGroups))
-	buf.WriteString(fmt.Sprintf("%d/", h.NumberOfRecords))
+	util.WriteBuffer(&total, &buf, h.FileControlTotal, maxLen)
+	buf.WriteString(",")
+	util.WriteBuffer(&total, &buf, fmt.Sprintf("%d", h.NumberOfGroups), maxLen)
+	buf.WriteString(",")
+	util.WriteBuffer(&total, &buf, fmt.Sprintf("%d", h.NumberOfRecords), maxLen)
+	buf.WriteString("/")
+
+	total.WriteString(buf.String())
 
-	return buf.String()
+	return total.String()
 }
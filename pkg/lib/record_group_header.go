@@ -19,33 +19,33 @@ const (
 type groupHeader struct {
 	Receiver         string `json:",omitempty"`
 	Originator       string
-	GroupStatus      int64
+	GroupStatus      GroupStatus
 	AsOfDate         string
-	AsOfTime         string `json:",omitempty"`
-	CurrencyCode     string `json:",omitempty"`
-	AsOfDateModifier int64  `json:",omitempty"`
+	AsOfTime         string           `json:",omitempty"`
+	CurrencyCode     string           `json:",omitempty"`
+	AsOfDateModifier AsOfDateModifier `json:",omitempty"`
 }
 
 func (h *groupHeader) validate() error {
 	if h.Originator == "" {
-		return fmt.Errorf(fmt.Sprintf(ghValidateErrorFmt, "Originator"))
+		return newValidationError(ghValidateErrorFmt, "Originator")
 	}
-	if h.GroupStatus < 0 || h.GroupStatus > 4 {
-		return fmt.Errorf(fmt.Sprintf(ghValidateErrorFmt, "GroupStatus"))
+	if h.GroupStatus != 0 && !h.GroupStatus.Valid() {
+		return newValidationError(ghValidateErrorFmt, "GroupStatus")
 	}
 	if h.AsOfDate == "" {
-		return fmt.Errorf(fmt.Sprintf(ghValidateErrorFmt, "AsOfDate"))
+		return newValidationError(ghValidateErrorFmt, "AsOfDate")
 	} else if !util.ValidateDate(h.AsOfDate) {
-		return fmt.Errorf(fmt.Sprintf(ghValidateErrorFmt, "AsOfDate"))
+		return newValidationError(ghValidateErrorFmt, "AsOfDate")
 	}
 	if h.AsOfTime != "" && !util.ValidateTime(h.AsOfTime) {
-		return fmt.Errorf(fmt.Sprintf(ghValidateErrorFmt, "AsOfTime"))
+		return newValidationError(ghValidateErrorFmt, "AsOfTime")
 	}
 	if h.CurrencyCode != "" && !util.ValidateCurrencyCode(h.CurrencyCode) {
-		return fmt.Errorf(fmt.Sprintf(ghValidateErrorFmt, "CurrencyCode"))
+		return newValidationError(ghValidateErrorFmt, "CurrencyCode")
 	}
-	if h.AsOfDateModifier < 0 || h.AsOfDateModifier > 4 {
-		return fmt.Errorf(fmt.Sprintf(ghValidateErrorFmt, "AsOfDateModifier"))
+	if h.AsOfDateModifier != 0 && !h.AsOfDateModifier.Valid() {
+		return newValidationError(ghValidateErrorFmt, "AsOfDateModifier")
 	}
 
 	return nil
@@ -56,65 +56,68 @@ func (h *groupHeader) parse(data string) (int, error) {
 	var line string
 	var err error
 	var size, read int
+	var value int64
 
 	if length := util.GetSize(data); length < 3 {
-		return 0, fmt.Errorf(fmt.Sprintf(ghParseErrorFmt, "record"))
+		return 0, newFieldError(ghParseErrorFmt, "record", read)
 	} else {
 		line = data[:length]
 	}
 
 	// RecordCode
 	if util.GroupHeaderCode != data[:2] {
-		return 0, fmt.Errorf(fmt.Sprintf(fhParseErrorFmt, "RecordCode"))
+		return 0, newFieldError(ghParseErrorFmt, "RecordCode", read)
 	}
 	read += 3
 
 	// Receiver
 	if h.Receiver, size, err = util.ReadField(line, read); err != nil {
-		return 0, fmt.Errorf(fmt.Sprintf(ghParseErrorFmt, "Receiver"))
+		return 0, newFieldError(ghParseErrorFmt, "Receiver", read)
 	} else {
 		read += size
 	}
 
 	// Originator
 	if h.Originator, size, err = util.ReadField(line, read); err != nil {
-		return 0, fmt.Errorf(fmt.Sprintf(ghParseErrorFmt, "Originator"))
+		return 0, newFieldError(ghParseErrorFmt, "Originator", read)
 	} else {
 		read += size
 	}
 
 	// GroupStatus
-	if h.GroupStatus, size, err = util.ReadFieldAsInt(line, read); err != nil {
-		return 0, fmt.Errorf(fmt.Sprintf(ghParseErrorFmt, "GroupStatus"))
+	if value, size, err = util.ReadFieldAsInt(line, read); err != nil {
+		return 0, newFieldError(ghParseErrorFmt, "GroupStatus", read)
 	} else {
+		h.GroupStatus = GroupStatus(value)
 		read += size
 	}
 
 	// AsOfDate
 	if h.AsOfDate, size, err = util.ReadField(line, read); err != nil {
-		return 0, fmt.Errorf(fmt.Sprintf(ghParseErrorFmt, "AsOfDate"))
+		return 0, newFieldError(ghParseErrorFmt, "AsOfDate", read)
 	} else {
 		read += size
 	}
 
 	// AsOfTime
 	if h.AsOfTime, size, err = util.ReadField(line, read); err != nil {
-		return 0, fmt.Errorf(fmt.Sprintf(ghParseErrorFmt, "AsOfTime"))
+		return 0, newFieldError(ghParseErrorFmt, "AsOfTime", read)
 	} else {
 		read += size
 	}
 
 	// CurrencyCode
 	if h.CurrencyCode, size, err = util.ReadField(line, read); err != nil {
-		return 0, fmt.Errorf(fmt.Sprintf(ghParseErrorFmt, "CurrencyCode"))
+		return 0, newFieldError(ghParseErrorFmt, "CurrencyCode", read)
 	} else {
 		read += size
 	}
 
 	// AsOfDateModifier
-	if h.AsOfDateModifier, size, err = util.ReadFieldAsInt(line, read); err != nil {
-		return 0, fmt.Errorf(fmt.Sprintf(ghParseErrorFmt, "AsOfDateModifier"))
+	if value, size, err = util.ReadFieldAsInt(line, read); err != nil {
+		return 0, newFieldError(ghParseErrorFmt, "AsOfDateModifier", read)
 	} else {
+		h.AsOfDateModifier = AsOfDateModifier(value)
 		read += size
 	}
 
@@ -125,21 +128,26 @@ func (h *groupHeader) parse(data string) (int, error) {
 	return read, nil
 }
 
-func (h *groupHeader) string() string {
-	var buf bytes.Buffer
+func (h *groupHeader) string(opts ...int64) string {
+
+	var maxLen int64
+	if len(opts) > 0 {
+		maxLen = opts[0]
+	}
+
+	var total, buf bytes.Buffer
 
 	buf.WriteString(fmt.Sprintf("%s,", util.GroupHeaderCode))
-	buf.WriteString(fmt.Sprintf("%s,", h.Receiver))
-	buf.WriteString(fmt.Sprintf("%s,", h.Originator))
-	buf.WriteString(fmt.Sprintf("%d,", h.GroupStatus))
-	buf.WriteString(fmt.Sprintf("%s,", h.AsOfDate))
-	buf.WriteString(fmt.Sprintf("%s,", h.AsOfTime))
-	buf.WriteString(fmt.Sprintf("%s,", h.CurrencyCode))
+	for _, field := range []string{h.Receiver, h.Originator, fmt.Sprintf("%d", h.GroupStatus), h.AsOfDate, h.AsOfTime, h.CurrencyCode} {
+		util.WriteBuffer(&total, &buf, field, maxLen)
+		buf.WriteString(",")
+	}
 	if h.AsOfDateModifier > 0 {
-		buf.WriteString(fmt.Sprintf("%d/", h.AsOfDateModifier))
-	} else {
-		buf.WriteString("/")
+		util.WriteBuffer(&total, &buf, fmt.Sprintf("%d", h.AsOfDateModifier), maxLen)
 	}
+	buf.WriteString("/")
+
+	total.WriteString(buf.String())
 
-	return buf.String()
+	return total.String()
 }
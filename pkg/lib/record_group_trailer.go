@@ -24,7 +24,7 @@ type groupTrailer struct {
 
 func (h *groupTrailer) validate() error {
 	if h.GroupControlTotal != "" && !util.ValidateAmount(h.GroupControlTotal) {
-		return fmt.Errorf(fmt.Sprintf(gtValidateErrorFmt, "GroupControlTotal"))
+		return newValidationError(gtValidateErrorFmt, "GroupControlTotal")
 	}
 
 	return nil
@@ -37,34 +37,34 @@ func (h *groupTrailer) parse(data string) (int, error) {
 	var size, read int
 
 	if length := util.GetSize(data); length < 3 {
-		return 0, fmt.Errorf(fmt.Sprintf(gtParseErrorFmt, "record"))
+		return 0, newFieldError(gtParseErrorFmt, "record", read)
 	} else {
 		line = data[:length]
 	}
 
 	// RecordCode
 	if util.GroupTrailerCode != data[:2] {
-		return 0, fmt.Errorf(fmt.Sprintf(fhParseErrorFmt, "RecordCode"))
+		return 0, newFieldError(gtParseErrorFmt, "RecordCode", read)
 	}
 	read += 3
 
 	// GroupControlTotal
 	if h.GroupControlTotal, size, err = util.ReadField(line, read); err != nil {
-		return 0, fmt.Errorf(fmt.Sprintf(gtParseErrorFmt, "GroupControlTotal"))
+		return 0, newFieldError(gtParseErrorFmt, "GroupControlTotal", read)
 	} else {
 		read += size
 	}
 
 	// NumberOfAccounts
 	if h.NumberOfAccounts, size, err = util.ReadFieldAsInt(line, read); err != nil {
-		return 0, fmt.Errorf(fmt.Sprintf(gtParseErrorFmt, "NumberOfAccounts"))
+		return 0, newFieldError(gtParseErrorFmt, "NumberOfAccounts", read)
 	} else {
 		read += size
 	}
 
 	// NumberOfRecords
 	if h.NumberOfRecords, size, err = util.ReadFieldAsInt(line, read); err != nil {
-		return 0, fmt.Errorf(fmt.Sprintf(gtParseErrorFmt, "NumberOfRecords"))
+		return 0, newFieldError(gtParseErrorFmt, "NumberOfRecords", read)
 	} else {
 		read += size
 	}
@@ -76,13 +76,24 @@ func (h *groupTrailer) parse(data string) (int, error) {
 	return read, nil
 }
 
-func (h *groupTrailer) string() string {
-	var buf bytes.Buffer
+func (h *groupTrailer) string(opts ...int64) string {
+
+	var maxLen int64
+	if len(opts) > 0 {
+		maxLen = opts[0]
+	}
+
+	var total, buf bytes.Buffer
 
 	buf.WriteString(fmt.Sprintf("%s,", util.GroupTrailerCode))
-	buf.WriteString(fmt.Sprintf("%s,", h.GroupControlTotal))
-	buf.WriteString(fmt.Sprintf("%d,", h.NumberOfAccounts))
-	buf.WriteString(fmt.Sprintf("%d/", h.NumberOfRecords))
+	util.WriteBuffer(&total, &buf, h.GroupControlTotal, maxLen)
+	buf.WriteString(",")
+	util.WriteBuffer(&total, &buf, fmt.Sprintf("%d", h.NumberOfAccounts), maxLen)
+	buf.WriteString(",")
+	util.WriteBuffer(&total, &buf, fmt.Sprintf("%d", h.NumberOfRecords), maxLen)
+	buf.WriteString("/")
+
+	total.WriteString(buf.String())
 
-	return buf.String()
+	return total.String()
 }
@@ -23,17 +23,21 @@ type transactionDetail struct {
 	BankReferenceNumber     string
 	CustomerReferenceNumber string
 	Text                    string
+
+	// effective currency of the account
+	currency string
 }
 
 func (r *transactionDetail) validate() error {
-	if r.TypeCode != "" && !util.ValidateTypeCode(r.TypeCode) {
-		return fmt.Errorf(fmt.Sprintf(tdValidateErrorFmt, "TypeCode"))
+	// customized type codes depend on the originator and are checked by the account
+	if r.TypeCode != "" && !validTypeCode("", r.TypeCode, util.TransactionDetailCode) && !isCustomTypeCode(r.TypeCode) {
+		return newValidationError(tdValidateErrorFmt, "TypeCode")
 	}
 	if r.Amount != "" && !util.ValidateAmount(r.Amount) {
-		return fmt.Errorf(fmt.Sprintf(tdValidateErrorFmt, "Amount"))
+		return newValidationError(tdValidateErrorFmt, "Amount")
 	}
 	if r.FundsType.Validate() != nil {
-		return fmt.Errorf(fmt.Sprintf(tdValidateErrorFmt, "FundsType"))
+		return newValidationError(tdValidateErrorFmt, "FundsType")
 	}
 
 	return nil
@@ -48,51 +52,51 @@ func (r *transactionDetail) parse(data string) (int, error) {
 	allow_slash_as_character := true
 	length := util.GetSize(data, allow_slash_as_character)
 	if length < 3 {
-		return 0, fmt.Errorf(fmt.Sprintf(tdParseErrorFmt, "record"))
+		return 0, newFieldError(tdParseErrorFmt, "record", read)
 	} else {
 		line = data[:length]
 	}
 
 	// RecordCode
 	if util.TransactionDetailCode != data[:2] {
-		return 0, fmt.Errorf(fmt.Sprintf(tdParseErrorFmt, "RecordCode"))
+		return 0, newFieldError(tdParseErrorFmt, "RecordCode", read)
 	}
 	read += 3
 
 	// TypeCode
 	if r.TypeCode, size, err = util.ReadField(line, read); err != nil {
-		return 0, fmt.Errorf(fmt.Sprintf(tdParseErrorFmt, "TypeCode"))
+		return 0, newFieldError(tdParseErrorFmt, "TypeCode", read)
 	} else {
 		read += size
 	}
 
 	// Amount
 	if r.Amount, size, err = util.ReadField(line, read); err != nil {
-		return 0, fmt.Errorf(fmt.Sprintf(tdParseErrorFmt, "Amount"))
+		return 0, newFieldError(tdParseErrorFmt, "Amount", read)
 	} else {
 		read += size
 	}
 
 	// FundsType
 	if len(line) < read {
-		return 0, fmt.Errorf(fmt.Sprintf(tdParseErrorFmt, "FundsType") + " too short")
+		return 0, &fieldError{field: "FundsType", offset: read, err: fmt.Errorf(tdParseErrorFmt+" too short", "FundsType")}
 	}
 	if size, err = r.FundsType.parse(line[read:]); err != nil {
-		return 0, fmt.Errorf(fmt.Sprintf(tdParseErrorFmt, "FundsType"))
+		return 0, newFieldError(tdParseErrorFmt, "FundsType", read)
 	} else {
 		read += size
 	}
 
 	// BankReferenceNumber
 	if r.BankReferenceNumber, size, err = util.ReadField(line, read, allow_slash_as_character); err != nil {
-		return 0, fmt.Errorf(fmt.Sprintf(tdParseErrorFmt, "BankReferenceNumber"))
+		return 0, newFieldError(tdParseErrorFmt, "BankReferenceNumber", read)
 	} else {
 		read += size
 	}
 
 	// CustomerReferenceNumber
 	if r.CustomerReferenceNumber, size, err = util.ReadField(line, read, allow_slash_as_character); err != nil {
-		return 0, fmt.Errorf(fmt.Sprintf(tdParseErrorFmt, "CustomerReferenceNumber"))
+		return 0, newFieldError(tdParseErrorFmt, "CustomerReferenceNumber", read)
 	} else {
 		read += size
 	}
@@ -100,7 +104,7 @@ func (r *transactionDetail) parse(data string) (int, error) {
 	// Text
 	read_remainder_of_line := true
 	if r.Text, size, err = util.ReadField(line, read, allow_slash_as_character, read_remainder_of_line); err != nil {
-		return 0, fmt.Errorf(fmt.Sprintf(tdParseErrorFmt, "Text"))
+		return 0, newFieldError(tdParseErrorFmt, "Text", read)
 	} else {
 		read += size
 	}
@@ -0,0 +1,100 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+// registries holds the registries used to interpret the type codes and the dates and times of a file, group or
+// account. A registry that is not set defaults to CustomTypeCodes or TimeZones.
+type registries struct {
+	typeCodes *TypeCodeRegistry
+	timeZones *TimeZoneRegistry
+}
+
+// RegistryOption sets a registry used to interpret files instead of its package-level default
+type RegistryOption func(*registries)
+
+// UseTypeCodes interprets customized type codes with the registry rather than CustomTypeCodes
+func UseTypeCodes(registry *TypeCodeRegistry) RegistryOption {
+	return func(r *registries) {
+		r.typeCodes = registry
+	}
+}
+
+// UseTimeZones interprets dates and times with the registry rather than TimeZones
+func UseTimeZones(registry *TimeZoneRegistry) RegistryOption {
+	return func(r *registries) {
+		r.timeZones = registry
+	}
+}
+
+// WithRegistries configures the scanner to interpret the files, groups and accounts it reads, including the
+// records returned by a Decoder, with the registries rather than CustomTypeCodes and TimeZones
+func WithRegistries(opts ...RegistryOption) ScannerOption {
+	return func(b *Bai2Scanner) {
+		b.registries = b.registries.with(opts)
+	}
+}
+
+// with returns the registries with the options applied
+func (r registries) with(opts []RegistryOption) registries {
+	for _, opt := range opts {
+		opt(&r)
+	}
+	return r
+}
+
+func (r registries) typeCodeRegistry() *TypeCodeRegistry {
+	if r.typeCodes == nil {
+		return CustomTypeCodes
+	}
+	return r.typeCodes
+}
+
+func (r registries) timeZoneRegistry() *TimeZoneRegistry {
+	if r.timeZones == nil {
+		return TimeZones
+	}
+	return r.timeZones
+}
+
+// UseRegistries sets the registries used to interpret the file, its groups and their accounts
+func (r *Bai2) UseRegistries(opts ...RegistryOption) {
+	r.registries = r.registries.with(opts)
+	for i := range r.Groups {
+		r.Groups[i].UseRegistries(opts...)
+	}
+}
+
+// UseRegistries sets the registries used to interpret the group and its accounts
+func (r *Group) UseRegistries(opts ...RegistryOption) {
+	r.registries = r.registries.with(opts)
+	for i := range r.Accounts {
+		r.Accounts[i].UseRegistries(opts...)
+	}
+}
+
+// UseRegistries sets the registries used to interpret the account
+func (r *Account) UseRegistries(opts ...RegistryOption) {
+	r.registries = r.registries.with(opts)
+}
+
+// LookupTypeCode returns the definition of a type code as used by the originator of the group
+func (r *Group) LookupTypeCode(code string) (TypeCodeDefinition, bool) {
+	return r.registries.typeCodeRegistry().Lookup(r.Originator, code)
+}
+
+// TypeCodeDirection returns the direction of a type code as used by the originator of the group
+func (r *Group) TypeCodeDirection(code string) TransactionDirection {
+	return r.registries.typeCodeRegistry().Direction(r.Originator, code)
+}
+
+// LookupTypeCode returns the definition of a type code as used by the originator of the group of the account
+func (r *Account) LookupTypeCode(code string) (TypeCodeDefinition, bool) {
+	return r.registries.typeCodeRegistry().Lookup(r.originator, code)
+}
+
+// TypeCodeDirection returns the direction of a type code as used by the originator of the group of the account
+func (r *Account) TypeCodeDirection(code string) TransactionDirection {
+	return r.registries.typeCodeRegistry().Direction(r.originator, code)
+}
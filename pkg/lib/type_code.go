@@ -0,0 +1,172 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+import (
+	"fmt"
+
+	"github.com/moov-io/bai2/pkg/util"
+)
+
+// Frequently used type codes of the Cash Management Balance Reporting Specification
+const (
+	// Status type codes
+	TypeCodeOpeningLedger      = "010"
+	TypeCodeClosingLedger      = "015"
+	TypeCodeCurrentLedger      = "030"
+	TypeCodeOpeningAvailable   = "040"
+	TypeCodeClosingAvailable   = "045"
+	TypeCodeCurrentAvailable   = "060"
+	TypeCodeTotalFloat         = "063"
+	TypeCodeZeroDayFloat       = "070"
+	TypeCodeOneDayFloat        = "072"
+	TypeCodeTwoOrMoreDaysFloat = "074"
+
+	// Summary type codes
+	TypeCodeTotalCredits = "100"
+	TypeCodeTotalDebits  = "400"
+
+	// Credit detail type codes
+	TypeCodeCreditAnyType          = "108"
+	TypeCodeLockboxDeposit         = "115"
+	TypeCodeACHCreditReceived      = "142"
+	TypeCodePreauthorizedACHCredit = "165"
+	TypeCodeIncomingMoneyTransfer  = "195"
+	TypeCodeMiscellaneousCredit    = "399"
+
+	// Debit detail type codes
+	TypeCodeACHDebitReceived      = "451"
+	TypeCodePreauthorizedACHDebit = "455"
+	TypeCodeCheckPaid             = "475"
+	TypeCodeOutgoingMoneyTransfer = "495"
+	TypeCodeMiscellaneousDebit    = "699"
+
+	// Non-monetary information
+	TypeCodeNonMonetaryInformation = "890"
+)
+
+// TypeCodeCategory is the level of reporting a type code is defined for
+type TypeCodeCategory int
+
+const (
+	// CategoryStatus codes report account balances in the account identifier (03) record
+	CategoryStatus TypeCodeCategory = iota + 1
+	// CategorySummary codes report totals in the account identifier (03) record
+	CategorySummary
+	// CategoryDetail codes report individual transactions in transaction detail (16) records
+	CategoryDetail
+	// CategorySummaryAndDetail codes, reserved for customized codes, may be used in either record
+	CategorySummaryAndDetail
+)
+
+var typeCodeCategoryNames = map[TypeCodeCategory]string{
+	CategoryStatus:           "Status",
+	CategorySummary:          "Summary",
+	CategoryDetail:           "Detail",
+	CategorySummaryAndDetail: "SummaryAndDetail",
+}
+
+func (c TypeCodeCategory) String() string {
+	if name, ok := typeCodeCategoryNames[c]; ok {
+		return name
+	}
+	return fmt.Sprintf("TypeCodeCategory(%d)", c)
+}
+
+// TransactionDirection tells whether the amount of a type code is a credit or a debit to the account
+type TransactionDirection int
+
+const (
+	// DirectionNone is used by status codes and non-monetary information
+	DirectionNone TransactionDirection = iota
+	DirectionCredit
+	DirectionDebit
+)
+
+var transactionDirectionNames = map[TransactionDirection]string{
+	DirectionNone:   "None",
+	DirectionCredit: "Credit",
+	DirectionDebit:  "Debit",
+}
+
+func (d TransactionDirection) String() string {
+	if name, ok := transactionDirectionNames[d]; ok {
+		return name
+	}
+	return fmt.Sprintf("TransactionDirection(%d)", d)
+}
+
+// TypeCodeDefinition describes a type code
+type TypeCodeDefinition struct {
+	Code      string
+	Name      string
+	Category  TypeCodeCategory
+	Direction TransactionDirection
+	// Custom is set for the 900-999 range, which the specification reserves for codes agreed between the parties
+	Custom bool
+}
+
+// AllowedIn reports whether the type code may be used in records with the given record code
+func (d TypeCodeDefinition) AllowedIn(recordCode string) bool {
+	switch d.Category {
+	case CategoryStatus, CategorySummary:
+		return recordCode == util.AccountIdentifierCode
+	case CategoryDetail:
+		return recordCode == util.TransactionDetailCode
+	case CategorySummaryAndDetail:
+		return recordCode == util.AccountIdentifierCode || recordCode == util.TransactionDetailCode
+	}
+	return false
+}
+
+var typeCodes = func() map[string]TypeCodeDefinition {
+	codes := make(map[string]TypeCodeDefinition, len(typeCodeTable))
+	for _, definition := range typeCodeTable {
+		codes[definition.Code] = definition
+	}
+	return codes
+}()
+
+// LookupTypeCode returns the definition of a type code. Codes in the 900-999 range are reported as
+// customized codes, with the category and direction of their range. Codes that the specification
+// does not assign are not found.
+func LookupTypeCode(code string) (TypeCodeDefinition, bool) {
+	if definition, ok := typeCodes[code]; ok {
+		return definition, true
+	}
+
+	if !util.ValidateTypeCode(code) || code < "900" {
+		return TypeCodeDefinition{}, false
+	}
+
+	definition := TypeCodeDefinition{Code: code, Name: "Customized Type Code", Custom: true}
+	switch {
+	case code < "920":
+		definition.Category = CategoryStatus
+	case code < "960":
+		definition.Category, definition.Direction = CategorySummaryAndDetail, DirectionCredit
+	default:
+		definition.Category, definition.Direction = CategorySummaryAndDetail, DirectionDebit
+	}
+
+	return definition, true
+}
+
+// TypeCodes returns the definitions of all type codes assigned by the specification, ordered by code
+func TypeCodes() []TypeCodeDefinition {
+	return append([]TypeCodeDefinition(nil), typeCodeTable...)
+}
+
+// TypeCodeDirection returns the direction of a type code used by the originator, taking the codes registered in
+// CustomTypeCodes into account
+func TypeCodeDirection(originator, code string) TransactionDirection {
+	return CustomTypeCodes.Direction(originator, code)
+}
+
+// validTypeCode reports whether a type code used by the originator may be used in records with the given
+// record code, taking the codes registered in CustomTypeCodes into account
+func validTypeCode(originator, code, recordCode string) bool {
+	return CustomTypeCodes.allows(originator, code, recordCode)
+}
@@ -0,0 +1,206 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/moov-io/bai2/pkg/util"
+)
+
+// CustomTypeCodes holds the customized type codes registered for each originator. It is used when validating and
+// summing accounts, which know the originator of their group, unless they are interpreted with another registry
+// given by UseTypeCodes.
+var CustomTypeCodes = NewTypeCodeRegistry()
+
+// TypeCodeRegistry holds customized type codes (900-999) per originator, identified by its routing number.
+// It is safe for concurrent use.
+type TypeCodeRegistry struct {
+	mu          sync.RWMutex
+	originators map[string]map[string]TypeCodeDefinition
+}
+
+func NewTypeCodeRegistry() *TypeCodeRegistry {
+	return &TypeCodeRegistry{
+		originators: make(map[string]map[string]TypeCodeDefinition),
+	}
+}
+
+// Register adds customized type codes used by the originator, replacing any previous definition of the same code.
+//
+// A definition without a category gets the category of the range of its code, as does the direction of
+// a definition that is not a status code.
+func (r *TypeCodeRegistry) Register(originator string, definitions ...TypeCodeDefinition) error {
+	if originator == "" {
+		return fmt.Errorf("invalid originator for customized type codes")
+	}
+
+	resolved := make([]TypeCodeDefinition, 0, len(definitions))
+	for _, definition := range definitions {
+		def, ok := LookupTypeCode(definition.Code)
+		if !ok || !def.Custom {
+			return fmt.Errorf("type code %q is not in the customized range 900-999", definition.Code)
+		}
+
+		if definition.Name != "" {
+			def.Name = definition.Name
+		}
+		if definition.Category != 0 {
+			def.Category = definition.Category
+		}
+		if def.Category == CategoryStatus {
+			def.Direction = DirectionNone
+		} else if definition.Direction != DirectionNone {
+			def.Direction = definition.Direction
+		}
+		if def.Category != CategoryStatus && def.Direction == DirectionNone {
+			return fmt.Errorf("type code %s of originator %s needs a credit or debit direction", def.Code, originator)
+		}
+
+		resolved = append(resolved, def)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	codes, ok := r.originators[originator]
+	if !ok {
+		codes = make(map[string]TypeCodeDefinition)
+		r.originators[originator] = codes
+	}
+	for _, definition := range resolved {
+		codes[definition.Code] = definition
+	}
+
+	return nil
+}
+
+// Lookup returns the definition of a type code as used by the originator. Codes registered for the
+// originator take precedence over the definitions returned by LookupTypeCode.
+func (r *TypeCodeRegistry) Lookup(originator, code string) (TypeCodeDefinition, bool) {
+	if r != nil && originator != "" {
+		r.mu.RLock()
+		definition, ok := r.originators[originator][code]
+		r.mu.RUnlock()
+		if ok {
+			return definition, true
+		}
+	}
+	return LookupTypeCode(code)
+}
+
+// Direction returns the direction of a type code used by the originator, falling back to the ranges reserved by
+// the specification for credit (100-399) and debit (400-699) codes when the code is not assigned
+func (r *TypeCodeRegistry) Direction(originator, code string) TransactionDirection {
+	if definition, ok := r.Lookup(originator, code); ok {
+		return definition.Direction
+	}
+
+	if util.ValidateTypeCode(code) {
+		switch {
+		case code >= "100" && code < "400":
+			return DirectionCredit
+		case code >= "400" && code < "700":
+			return DirectionDebit
+		}
+	}
+	return DirectionNone
+}
+
+// allows reports whether a type code used by the originator may be used in records with the given record code.
+// Codes that the specification does not assign are accepted.
+func (r *TypeCodeRegistry) allows(originator, code, recordCode string) bool {
+	if !util.ValidateTypeCode(code) {
+		return false
+	}
+	if definition, ok := r.Lookup(originator, code); ok {
+		return definition.AllowedIn(recordCode)
+	}
+	return true
+}
+
+// Load registers the customized type codes read from a YAML or JSON document, keyed by originator:
+//
+//	"121000358":
+//	  - code: "950"
+//	    name: Sweep Credit
+//	    category: detail
+//	    direction: credit
+//
+// The category is one of status, summary, detail or summaryAndDetail, the direction one of credit or debit.
+func (r *TypeCodeRegistry) Load(reader io.Reader) error {
+	var document map[string][]struct {
+		Code      string `yaml:"code"`
+		Name      string `yaml:"name"`
+		Category  string `yaml:"category"`
+		Direction string `yaml:"direction"`
+	}
+	if err := yaml.NewDecoder(reader).Decode(&document); err != nil && err != io.EOF {
+		return fmt.Errorf("unable to read customized type codes: %v", err)
+	}
+
+	for originator, entries := range document {
+		definitions := make([]TypeCodeDefinition, 0, len(entries))
+		for _, entry := range entries {
+			definition := TypeCodeDefinition{Code: entry.Code, Name: entry.Name}
+
+			var ok bool
+			if definition.Category, ok = parseTypeCodeCategory(entry.Category); !ok {
+				return fmt.Errorf("invalid category %q for type code %s of originator %s", entry.Category, entry.Code, originator)
+			}
+			if definition.Direction, ok = parseTransactionDirection(entry.Direction); !ok {
+				return fmt.Errorf("invalid direction %q for type code %s of originator %s", entry.Direction, entry.Code, originator)
+			}
+
+			definitions = append(definitions, definition)
+		}
+
+		if err := r.Register(originator, definitions...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func parseTypeCodeCategory(value string) (TypeCodeCategory, bool) {
+	if value == "" {
+		return 0, true
+	}
+	for category, name := range typeCodeCategoryNames {
+		if strings.EqualFold(value, name) {
+			return category, true
+		}
+	}
+	return 0, false
+}
+
+func parseTransactionDirection(value string) (TransactionDirection, bool) {
+	if value == "" {
+		return DirectionNone, true
+	}
+	for direction, name := range transactionDirectionNames {
+		if strings.EqualFold(value, name) {
+			return direction, true
+		}
+	}
+	return DirectionNone, false
+}
+
+// LookupOriginatorTypeCode returns the definition of a type code as used by the originator,
+// taking the codes registered in CustomTypeCodes into account
+func LookupOriginatorTypeCode(originator, code string) (TypeCodeDefinition, bool) {
+	return CustomTypeCodes.Lookup(originator, code)
+}
+
+// isCustomTypeCode reports whether the type code is in the customized range, whose meaning depends on the originator
+func isCustomTypeCode(code string) bool {
+	return util.ValidateTypeCode(code) && code >= "900"
+}
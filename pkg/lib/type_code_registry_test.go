@@ -0,0 +1,166 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypeCodeRegistry(t *testing.T) {
+	registry := NewTypeCodeRegistry()
+	require.NoError(t, registry.Register("121000358",
+		TypeCodeDefinition{Code: "905", Name: "Sweep Credit", Category: CategoryDetail, Direction: DirectionCredit},
+		TypeCodeDefinition{Code: "970", Name: "Pooling Debit"},
+	))
+
+	definition, ok := registry.Lookup("121000358", "905")
+	require.True(t, ok)
+	require.Equal(t, TypeCodeDefinition{Code: "905", Name: "Sweep Credit", Category: CategoryDetail, Direction: DirectionCredit, Custom: true}, definition)
+
+	definition, ok = registry.Lookup("121000358", "970")
+	require.True(t, ok)
+	require.Equal(t, TypeCodeDefinition{Code: "970", Name: "Pooling Debit", Category: CategorySummaryAndDetail, Direction: DirectionDebit, Custom: true}, definition)
+
+	// other originators get the definitions of the specification
+	definition, ok = registry.Lookup("0004", "905")
+	require.True(t, ok)
+	require.Equal(t, "Customized Type Code", definition.Name)
+	require.Equal(t, CategoryStatus, definition.Category)
+
+	definition, ok = registry.Lookup("121000358", TypeCodeCheckPaid)
+	require.True(t, ok)
+	require.Equal(t, "Check Paid", definition.Name)
+
+	require.EqualError(t, registry.Register("121000358", TypeCodeDefinition{Code: TypeCodeCheckPaid}),
+		`type code "475" is not in the customized range 900-999`)
+	require.EqualError(t, registry.Register("121000358", TypeCodeDefinition{Code: "901", Category: CategoryDetail}),
+		"type code 901 of originator 121000358 needs a credit or debit direction")
+	require.EqualError(t, registry.Register("", TypeCodeDefinition{Code: "901"}),
+		"invalid originator for customized type codes")
+}
+
+func TestTypeCodeRegistry_Load(t *testing.T) {
+	yamlDocument := `
+"121000358":
+  - code: "905"
+    name: Sweep Credit
+    category: detail
+    direction: credit
+  - code: "910"
+    name: Target Balance
+"0004":
+  - code: "950"
+    direction: Debit
+`
+	registry := NewTypeCodeRegistry()
+	require.NoError(t, registry.Load(strings.NewReader(yamlDocument)))
+
+	definition, _ := registry.Lookup("121000358", "905")
+	require.Equal(t, DirectionCredit, definition.Direction)
+	require.Equal(t, CategoryDetail, definition.Category)
+
+	definition, _ = registry.Lookup("121000358", "910")
+	require.Equal(t, "Target Balance", definition.Name)
+	require.Equal(t, CategoryStatus, definition.Category)
+
+	definition, _ = registry.Lookup("0004", "950")
+	require.Equal(t, DirectionDebit, definition.Direction)
+
+	jsonDocument := `{"121000358": [{"code": "999", "name": "Bank Fee", "category": "summaryAndDetail", "direction": "debit"}]}`
+	require.NoError(t, registry.Load(strings.NewReader(jsonDocument)))
+
+	definition, _ = registry.Lookup("121000358", "999")
+	require.Equal(t, "Bank Fee", definition.Name)
+
+	err := registry.Load(strings.NewReader(`{"121000358": [{"code": "999", "direction": "sideways"}]}`))
+	require.EqualError(t, err, `invalid direction "sideways" for type code 999 of originator 121000358`)
+
+	err = registry.Load(strings.NewReader(`{"121000358": [{"code": "999", "category": "other"}]}`))
+	require.EqualError(t, err, `invalid category "other" for type code 999 of originator 121000358`)
+
+	require.Error(t, registry.Load(strings.NewReader(`[`)))
+}
+
+func TestCustomTypeCodes(t *testing.T) {
+	registry := NewTypeCodeRegistry()
+	require.NoError(t, registry.Register("121000358",
+		TypeCodeDefinition{Code: "905", Name: "Sweep Credit", Category: CategoryDetail, Direction: DirectionCredit},
+		TypeCodeDefinition{Code: "930", Name: "Sweep Reversal", Direction: DirectionDebit},
+	))
+
+	group := Group{
+		Receiver:    "121000358",
+		Originator:  "121000358",
+		GroupStatus: 1,
+		AsOfDate:    "060317",
+		Accounts: []Account{
+			{
+				AccountNumber: "10200123456",
+				Details: []Detail{
+					{TypeCode: "905", Amount: "1000"},
+					{TypeCode: "930", Amount: "300"},
+				},
+			},
+		},
+	}
+	require.NoError(t, group.Finalize())
+	require.EqualError(t, group.Validate(), "TransactionDetail: invalid TypeCode")
+	require.NoError(t, group.Validate(UseTypeCodes(registry)))
+
+	group.UseRegistries(UseTypeCodes(registry))
+	require.NoError(t, group.Validate())
+
+	total, err := group.Accounts[0].SumNetDetailAmounts()
+	require.NoError(t, err)
+	require.Equal(t, "700", total)
+
+	// 905 is a status code for originators that did not register it
+	group.Originator = "0004"
+	require.EqualError(t, group.Validate(), "TransactionDetail: invalid TypeCode")
+
+	file, err := NewFileBuilder().
+		Registries(UseTypeCodes(registry)).
+		Sender("121000358").
+		Receiver("0004").
+		Created(time.Date(2006, time.March, 21, 8, 29, 0, 0, time.UTC)).
+		FileID("1").
+		Group("121000358", time.Date(2006, time.March, 17, 0, 0, 0, 0, time.UTC)).
+		Account("10200123456").
+		Credit("905", 1000).
+		Debit("930", 300).
+		Build()
+	require.NoError(t, err)
+	require.Len(t, file.Groups[0].Accounts[0].Details, 2)
+
+	_, err = NewFileBuilder().Registries(UseTypeCodes(registry)).Group("0004", time.Now()).Account("10200123456").Credit("905", 1000).Build()
+	require.EqualError(t, err, "FileBuilder: invalid TypeCode")
+
+	// the codes are not registered in CustomTypeCodes
+	_, err = NewFileBuilder().Group("121000358", time.Now()).Account("10200123456").Credit("905", 1000).Build()
+	require.EqualError(t, err, "FileBuilder: invalid TypeCode")
+
+	// the scanner interprets the files it reads with the registry
+	scan := NewBai2Scanner(strings.NewReader(file.String()), WithRegistries(UseTypeCodes(registry)))
+	read := NewBai2()
+	require.NoError(t, read.Read(&scan))
+	require.NoError(t, read.Validate())
+	require.Equal(t, DirectionDebit, read.Groups[0].TypeCodeDirection("930"))
+	require.Equal(t, DirectionDebit, read.Groups[0].Accounts[0].TypeCodeDirection("930"))
+
+	scan = NewBai2Scanner(strings.NewReader(file.String()), WithRegistries(UseTypeCodes(registry)))
+	decoder := NewDecoder(&scan)
+	for {
+		event, err := decoder.Next()
+		require.NoError(t, err)
+		if event.Type == AccountIdentifierEvent {
+			require.Equal(t, DirectionCredit, event.Account.TypeCodeDirection("905"))
+			break
+		}
+	}
+}
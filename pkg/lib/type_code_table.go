@@ -0,0 +1,478 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+// typeCodeTable lists the type codes of the Cash Management Balance Reporting Specification, Version 2.
+var typeCodeTable = []TypeCodeDefinition{
+	{Code: "010", Name: "Opening Ledger", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "011", Name: "Average Opening Ledger MTD", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "012", Name: "Average Opening Ledger YTD", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "015", Name: "Closing Ledger", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "020", Name: "Average Closing Ledger MTD", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "021", Name: "Average Closing Ledger - Previous Month", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "022", Name: "Aggregate Balance Adjustments", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "024", Name: "Average Closing Ledger YTD - Previous Month", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "025", Name: "Average Closing Ledger YTD", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "030", Name: "Current Ledger", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "037", Name: "ACH Net Position", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "039", Name: "Opening Available + Total Same-Day ACH DTC Deposit", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "040", Name: "Opening Available", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "041", Name: "Average Opening Available MTD", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "042", Name: "Average Opening Available YTD", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "043", Name: "Average Available - Previous Month", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "044", Name: "Disbursing Opening Available Balance", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "045", Name: "Closing Available", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "050", Name: "Average Closing Available MTD", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "051", Name: "Average Closing Available - Last Month", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "054", Name: "Average Closing Available YTD - Last Month", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "055", Name: "Average Closing Available YTD", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "056", Name: "Loan Balance", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "057", Name: "Total Investment Position", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "059", Name: "Current Available (CRS Supressed)", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "060", Name: "Current Available", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "061", Name: "Average Current Available MTD", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "062", Name: "Average Current Available YTD", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "063", Name: "Total Float", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "065", Name: "Target Balance", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "066", Name: "Adjusted Balance", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "067", Name: "Adjusted Balance MTD", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "068", Name: "Adjusted Balance YTD", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "070", Name: "0-Day Float", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "072", Name: "1-Day Float", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "073", Name: "Float Adjustment", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "074", Name: "2 or More Days Float", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "075", Name: "3 or More Days Float", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "076", Name: "Adjustment to Balances", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "077", Name: "Average Adjustment to Balances MTD", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "078", Name: "Average Adjustment to Balances YTD", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "079", Name: "4-Day Float", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "080", Name: "5-Day Float", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "081", Name: "6-Day Float", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "082", Name: "Average 1-Day Float MTD", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "083", Name: "Average 1-Day Float YTD", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "084", Name: "Average 2-Day Float MTD", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "085", Name: "Average 2-Day Float YTD", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "086", Name: "Transfer Calculation", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "100", Name: "Total Credits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "101", Name: "Total Credit Amount MTD", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "105", Name: "Credits Not Detailed", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "106", Name: "Deposits Subject to Float", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "107", Name: "Total Adjustment Credits YTD", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "108", Name: "Credit (Any Type)", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "109", Name: "Current Day Total Lockbox Deposits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "110", Name: "Total Lockbox Deposits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "115", Name: "Lockbox Deposit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "116", Name: "Item in Lockbox Deposit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "118", Name: "Lockbox Adjustment Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "120", Name: "EDI* Transaction Credit", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "121", Name: "EDI Transaction Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "122", Name: "EDIBANX Credit Received", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "123", Name: "EDIBANX Credit Return", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "130", Name: "Total Concentration Credits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "131", Name: "Total DTC Credits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "135", Name: "DTC Concentration Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "136", Name: "Item in DTC Deposit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "140", Name: "Total ACH Credits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "142", Name: "ACH Credit Received", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "143", Name: "Item in ACH Deposit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "145", Name: "ACH Concentration Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "146", Name: "Total Bank Card Deposits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "147", Name: "Individual Bank Card Deposit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "150", Name: "Total Preauthorized Payment Credits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "155", Name: "Preauthorized Draft Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "156", Name: "Item in PAC Deposit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "160", Name: "Total ACH Disbursing Funding Credits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "162", Name: "Corporate Trade Payment Settlement", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "163", Name: "Corporate Trade Payment Credits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "164", Name: "Corporate Trade Payment Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "165", Name: "Preauthorized ACH Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "166", Name: "ACH Settlement", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "167", Name: "ACH Settlement Credits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "168", Name: "ACH Return Item or Adjustment Settlement", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "169", Name: "Miscellaneous ACH Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "170", Name: "Total Other Check Deposits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "171", Name: "Individual Loan Deposit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "172", Name: "Deposit Correction", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "173", Name: "Bank-Prepared Deposit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "174", Name: "Other Deposit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "175", Name: "Check Deposit Package", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "176", Name: "Re-presented Check Deposit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "178", Name: "List Post Credits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "180", Name: "Total Loan Proceeds", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "182", Name: "Total Bank-Prepared Deposits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "184", Name: "Draft Deposit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "185", Name: "Total Miscellaneous Deposits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "186", Name: "Total Cash Letter Credits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "187", Name: "Cash Letter Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "188", Name: "Total Cash Letter Adjustments", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "189", Name: "Cash Letter Adjustment", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "190", Name: "Total Incoming Money Transfers", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "191", Name: "Individual Incoming Internal Money Transfer", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "195", Name: "Incoming Money Transfer", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "196", Name: "Money Transfer Adjustment", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "198", Name: "Compensation", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "200", Name: "Total Automatic Transfer Credits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "201", Name: "Individual Automatic Transfer Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "202", Name: "Bond Operations Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "205", Name: "Total Book Transfer Credits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "206", Name: "Book Transfer Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "207", Name: "Total International Money Transfer Credits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "208", Name: "Individual International Money Transfer Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "210", Name: "Total International Credits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "212", Name: "Foreign Letter of Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "213", Name: "Letter of Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "214", Name: "Foreign Exchange of Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "215", Name: "Total Letters of Credit", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "216", Name: "Foreign Remittance Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "218", Name: "Foreign Collection Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "221", Name: "Foreign Check Purchase", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "222", Name: "Foreign Checks Deposited", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "224", Name: "Commission", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "226", Name: "International Money Market Trading", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "227", Name: "Standing Order", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "229", Name: "Miscellaneous International Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "230", Name: "Total Security Credits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "231", Name: "Total Collection Credits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "232", Name: "Sale of Debt Security", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "233", Name: "Securities Sold", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "234", Name: "Sale of Equity Security", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "235", Name: "Matured Reverse Repurchase Order", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "236", Name: "Maturity of Debt Security", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "237", Name: "Individual Collection Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "238", Name: "Collection of Dividends", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "239", Name: "Total Bankers' Acceptance Credits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "240", Name: "Coupon Collections - Banks", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "241", Name: "Bankers' Acceptances", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "242", Name: "Collection of Interest Income", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "243", Name: "Matured Fed Funds Purchased", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "244", Name: "Interest/Matured Principal Payment", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "245", Name: "Monthly Dividends", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "246", Name: "Commercial Paper", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "247", Name: "Capital Change", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "248", Name: "Savings Bonds Sales Adjustment", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "249", Name: "Miscellaneous Security Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "250", Name: "Total Checks Posted and Returned", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "251", Name: "Total Debit Reversals", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "252", Name: "Debit Reversal", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "254", Name: "Posting Error Correction Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "255", Name: "Check Posted and Returned", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "256", Name: "Total ACH Return Items", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "257", Name: "Individual ACH Return Item", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "258", Name: "ACH Reversal Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "260", Name: "Total Rejected Credits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "261", Name: "Individual Rejected Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "263", Name: "Overdraft", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "266", Name: "Return Item", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "268", Name: "Return Item Adjustment", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "270", Name: "Total ZBA Credits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "271", Name: "Net Zero-Balance Amount", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "274", Name: "Cumulative** ZBA or Disbursement Credits", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "275", Name: "ZBA Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "276", Name: "ZBA Float Adjustment", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "277", Name: "ZBA Credit Transfer", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "278", Name: "ZBA Credit Adjustment", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "280", Name: "Total Controlled Disbursing Credits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "281", Name: "Individual Controlled Disbursing Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "285", Name: "Total DTC Disbursing Credits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "286", Name: "Individual DTC Disbursing Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "294", Name: "Total ATM Credits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "295", Name: "ATM Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "301", Name: "Commercial Deposit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "302", Name: "Correspondent Bank Deposit", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "303", Name: "Total Wire Transfers In - FF", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "304", Name: "Total Wire Transfers In - CHF", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "305", Name: "Total Fed Funds Sold", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "306", Name: "Fed Funds Sold", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "307", Name: "Total Trust Credits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "308", Name: "Trust Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "309", Name: "Total Value - Dated Funds", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "310", Name: "Total Commercial Deposits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "315", Name: "Total International Credits - FF", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "316", Name: "Total International Credits - CHF", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "318", Name: "Total Foreign Check Purchased", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "319", Name: "Late Deposit", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "320", Name: "Total Securities Sold - FF", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "321", Name: "Total Securities Sold - CHF", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "324", Name: "Total Securities Matured - FF", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "325", Name: "Total Securities Matured - CHF", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "326", Name: "Total Securities Interest", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "327", Name: "Total Securities Matured", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "328", Name: "Total Securities Interest - FF", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "329", Name: "Total Securities Interest - CHF", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "330", Name: "Total Escrow Credits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "331", Name: "Individual Escrow Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "332", Name: "Total Miscellaneous Securities Credits - FF", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "336", Name: "Total Miscellaneous Securities Credits - CHF", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "338", Name: "Total Securities Sold", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "340", Name: "Total Broker Deposits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "341", Name: "Total Broker Deposits - FF", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "342", Name: "Broker Deposit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "343", Name: "Total Broker Deposits - CHF", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "344", Name: "Individual Back Value Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "345", Name: "Item in Brokers Deposit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "346", Name: "Sweep Interest Income", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "347", Name: "Sweep Principal Sell", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "348", Name: "Futures Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "349", Name: "Principal Payments Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "350", Name: "Investment Sold", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "351", Name: "Individual Investment Sold", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "352", Name: "Total Cash Center Credits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "353", Name: "Cash Center Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "354", Name: "Interest Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "355", Name: "Investment Interest", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "356", Name: "Total Credit Adjustment", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "357", Name: "Credit Adjustment", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "358", Name: "YTD Adjustment Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "359", Name: "Interest Adjustment Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "360", Name: "Total Credits Less Wire Transfer and Returned Checks", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "361", Name: "Grand Total Credits Less Grand Total Debits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "362", Name: "Correspondent Collection", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "363", Name: "Correspondent Collection Adjustment", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "364", Name: "Loan Participation", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "366", Name: "Currency and Coin Deposited", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "367", Name: "Food Stamp Letter", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "368", Name: "Food Stamp Adjustment", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "369", Name: "Clearing Settlement Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "370", Name: "Total Back Value Credits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "372", Name: "Back Value Adjustment", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "373", Name: "Customer Payroll", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "374", Name: "FRB Statement Recap", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "376", Name: "Savings Bond Letter or Adjustment", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "377", Name: "Treasury Tax and Loan Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "378", Name: "Transfer of Treasury Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "379", Name: "FRB Government Checks Cash Letter Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "381", Name: "FRB Government Check Adjustment", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "382", Name: "FRB Postal Money Order Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "383", Name: "FRB Postal Money Order Adjustment", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "384", Name: "FRB Cash Letter Auto Charge Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "385", Name: "Total Universal Credits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "386", Name: "FRB Cash Letter Auto Charge Adjustment", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "387", Name: "FRB Fine-Sort Cash Letter Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "388", Name: "FRB Fine-Sort Adjustment", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "389", Name: "Total Freight Payment Credits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "390", Name: "Total Miscellaneous Credits", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "391", Name: "Universal Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "392", Name: "Freight Payment Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "393", Name: "Itemized Credit Over $10,000", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "394", Name: "Cumulative** Credits", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "395", Name: "Check Reversal", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "397", Name: "Float Adjustment", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "398", Name: "Miscellaneous Fee Refund", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "399", Name: "Miscellaneous Credit", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "400", Name: "Total Debits", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "401", Name: "Total Debit Amount MTD", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "403", Name: "Today's Total Debits", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "405", Name: "Total Debit Less Wire Transfers and Charge-Backs", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "406", Name: "Debits not Detailed", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "408", Name: "Float Adjustment", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "409", Name: "Debit (Any Type)", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "410", Name: "Total YTD Adjustment", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "412", Name: "Total Debits (Excluding Returned Items)", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "415", Name: "Lockbox Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "416", Name: "Total Lockbox Debits", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "420", Name: "EDI Transaction Debits", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "421", Name: "EDI Transaction Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "422", Name: "EDIBANX Settlement Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "423", Name: "EDIBANX Return Item Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "430", Name: "Total Payable-Through Drafts", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "435", Name: "Payable-Through Draft", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "445", Name: "ACH Concentration Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "446", Name: "Total ACH Disbursement Funding Debits", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "447", Name: "ACH Disbursement Funding Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "450", Name: "Total ACH Debits", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "451", Name: "ACH Debit Received", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "452", Name: "Item in ACH Disbursement or Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "455", Name: "Preauthorized ACH Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "462", Name: "Account Holder Initiated ACH Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "463", Name: "Corporate Trade Payment Debits", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "464", Name: "Corporate Trade Payment Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "465", Name: "Corporate Trade Payment Settlement", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "466", Name: "ACH Settlement", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "467", Name: "ACH Settlement Debits", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "468", Name: "ACH Return Item or Adjustment Settlement", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "469", Name: "Miscellaneous ACH Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "470", Name: "Total Check Paid", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "471", Name: "Total Check Paid - Cumulative MTD", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "472", Name: "Cumulative** Checks Paid", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "474", Name: "Certified Check Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "475", Name: "Check Paid", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "476", Name: "Federal Reserve Bank Letter Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "477", Name: "Bank Originated Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "478", Name: "List Post Debits", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "479", Name: "List Post Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "480", Name: "Total Loan Payments", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "481", Name: "Individual Loan Payment", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "482", Name: "Total Bank-Originated Debits", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "484", Name: "Draft", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "485", Name: "DTC Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "486", Name: "Total Cash Letter Debits", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "487", Name: "Cash Letter Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "489", Name: "Cash Letter Adjustment", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "490", Name: "Total Outgoing Money Transfers", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "491", Name: "Individual Outgoing Internal Money Transfer", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "493", Name: "Customer Terminal Initiated Money Transfer", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "495", Name: "Outgoing Money Transfer", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "496", Name: "Money Transfer Adjustment", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "498", Name: "Compensation", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "500", Name: "Total Automatic Transfer Debits", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "501", Name: "Individual Automatic Transfer Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "502", Name: "Bond Operations Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "505", Name: "Total Book Transfer Debits", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "506", Name: "Book Transfer Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "507", Name: "Total International Money Transfer Debits", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "508", Name: "Individual International Money Transfer Debits", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "510", Name: "Total International Debits", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "512", Name: "Letter of Credit Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "513", Name: "Letter of Credit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "514", Name: "Foreign Exchange Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "515", Name: "Total Letters of Credit", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "516", Name: "Foreign Remittance Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "518", Name: "Foreign Collection Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "522", Name: "Foreign Checks Paid", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "524", Name: "Commission", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "526", Name: "International Money Market Trading", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "527", Name: "Standing Order", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "529", Name: "Miscellaneous International Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "530", Name: "Total Security Debits", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "531", Name: "Securities Purchased", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "532", Name: "Total Amount of Securities Purchased", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "533", Name: "Security Collection Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "534", Name: "Total Miscellaneous Securities DB - FF", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "535", Name: "Purchase of Equity Securities", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "536", Name: "Total Miscellaneous Securities Debit - CHF", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "537", Name: "Total Collection Debit", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "538", Name: "Matured Repurchase Order", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "539", Name: "Total Bankers' Acceptances Debit", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "540", Name: "Coupon Collection Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "541", Name: "Bankers' Acceptances", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "542", Name: "Purchase of Debt Securities", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "543", Name: "Domestic Collection", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "544", Name: "Interest/Matured Principal Payment", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "546", Name: "Commercial paper", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "547", Name: "Capital Change", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "548", Name: "Savings Bonds Sales Adjustment", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "549", Name: "Miscellaneous Security Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "550", Name: "Total Deposited Items Returned", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "551", Name: "Total Credit Reversals", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "552", Name: "Credit Reversal", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "554", Name: "Posting Error Correction Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "555", Name: "Deposited Item Returned", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "556", Name: "Total ACH Return Items", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "557", Name: "Individual ACH Return Item", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "558", Name: "ACH Reversal Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "560", Name: "Total Rejected Debits", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "561", Name: "Individual Rejected Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "563", Name: "Overdraft", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "564", Name: "Overdraft Fee", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "566", Name: "Return Item", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "567", Name: "Return Item Fee", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "568", Name: "Return Item Adjustment", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "570", Name: "Total ZBA Debits", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "574", Name: "Cumulative ZBA Debits", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "575", Name: "ZBA Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "577", Name: "ZBA Debit Transfer", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "578", Name: "ZBA Debit Adjustment", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "580", Name: "Total Controlled Disbursing Debits", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "581", Name: "Individual Controlled Disbursing Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "583", Name: "Total Disbursing Checks Paid - Early Amount", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "584", Name: "Total Disbursing Checks Paid - Later Amount", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "585", Name: "Disbursing Funding Requirement", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "586", Name: "FRB Presentment Estimate (Fed Estimate)", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "587", Name: "Late Debits (After Notification)", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "588", Name: "Total Disbursing Checks Paid-Last Amount", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "590", Name: "Total DTC Debits", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "594", Name: "Total ATM Debits", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "595", Name: "ATM Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "596", Name: "Total APR Debits", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "597", Name: "ARP Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "601", Name: "Estimated Total Disbursement", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "602", Name: "Adjusted Total Disbursement", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "610", Name: "Total Funds Required", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "611", Name: "Total Wire Transfers Out- CHF", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "612", Name: "Total Wire Transfers Out - FF", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "613", Name: "Total International Debit - CHF", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "614", Name: "Total International Debit - FF", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "615", Name: "Total Federal Reserve Bank - Commercial Bank Debit", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "616", Name: "Federal Reserve Bank - Commercial Bank Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "617", Name: "Total Securities Purchased - CHF", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "618", Name: "Total Securities Purchased - FF", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "621", Name: "Total Broker Debits - CHF", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "622", Name: "Broker Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "623", Name: "Total Broker Debits - FF", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "625", Name: "Total Broker Debits", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "626", Name: "Total Fed Funds Purchased", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "627", Name: "Fed Funds Purchased", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "628", Name: "Total Cash Center Debits", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "629", Name: "Cash Center Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "630", Name: "Total Debit Adjustments", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "631", Name: "Debit Adjustment", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "632", Name: "Total Trust Debits", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "633", Name: "Trust Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "634", Name: "YTD Adjustment Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "640", Name: "Total Escrow Debits", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "641", Name: "Individual Escrow Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "644", Name: "Individual Back Value Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "646", Name: "Transfer Calculation Debit", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "650", Name: "Investments Purchased", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "651", Name: "Individual Investment purchased", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "654", Name: "Interest Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "655", Name: "Total Investment Interest Debits", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "656", Name: "Sweep Principal Buy", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "657", Name: "Futures Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "658", Name: "Principal Payments Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "659", Name: "Interest Adjustment Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "661", Name: "Account Analysis Fee", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "662", Name: "Correspondent Collection Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "663", Name: "Correspondent Collection Adjustment", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "664", Name: "Loan Participation", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "665", Name: "Intercept Debits", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "666", Name: "Currency and Coin Shipped", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "667", Name: "Food Stamp Letter", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "668", Name: "Food Stamp Adjustment", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "669", Name: "Clearing Settlement Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "670", Name: "Total Back Value Debits", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "672", Name: "Back Value Adjustment", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "673", Name: "Customer Payroll", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "674", Name: "FRB Statement Recap", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "676", Name: "Savings Bond Letter or Adjustment", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "677", Name: "Treasury Tax and Loan Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "678", Name: "Transfer of Treasury Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "679", Name: "FRB Government Checks Cash Letter Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "681", Name: "FRB Government Check Adjustment", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "682", Name: "FRB Postal Money Order Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "683", Name: "FRB Postal Money Order Adjustment", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "684", Name: "FRB Cash Letter Auto Charge Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "685", Name: "Total Universal Debits", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "686", Name: "FRB Cash Letter Auto Charge Adjustment", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "687", Name: "FRB Fine-Sort Cash Letter Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "688", Name: "FRB Fine-Sort Adjustment", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "689", Name: "FRB Freight Payment Debits", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "690", Name: "Total Miscellaneous Debits", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "691", Name: "Universal Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "692", Name: "Freight Payment Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "693", Name: "Itemized Debit Over $10,000", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "694", Name: "Deposit Reversal", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "695", Name: "Deposit Correction Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "696", Name: "Regular Collection Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "697", Name: "Cumulative** Debits", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "698", Name: "Miscellaneous Fees", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "699", Name: "Miscellaneous Debit", Category: CategoryDetail, Direction: DirectionDebit},
+	{Code: "701", Name: "Principal Loan Balance", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "703", Name: "Available Commitment Amount", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "705", Name: "Payment Amount Due", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "707", Name: "Principal Amount Past Due", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "709", Name: "Interest Amount Past Due", Category: CategoryStatus, Direction: DirectionNone},
+	{Code: "720", Name: "Total Loan Payment", Category: CategorySummary, Direction: DirectionCredit},
+	{Code: "721", Name: "Amount Applied to Interest", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "722", Name: "Amount Applied to Principal", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "723", Name: "Amount Applied to Escrow", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "724", Name: "Amount Applied to Late Charges", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "725", Name: "Amount Applied to Buydown", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "726", Name: "Amount Applied to Misc. Fees", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "727", Name: "Amount Applied to Deferred Interest Detail", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "728", Name: "Amount Applied to Service Charge", Category: CategoryDetail, Direction: DirectionCredit},
+	{Code: "760", Name: "Loan Disbursement", Category: CategorySummary, Direction: DirectionDebit},
+	{Code: "890", Name: "Contains Non-monetary Information", Category: CategoryDetail, Direction: DirectionNone},
+}
@@ -0,0 +1,107 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+import (
+	"testing"
+
+	"github.com/moov-io/bai2/pkg/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookupTypeCode(t *testing.T) {
+	definition, ok := LookupTypeCode(TypeCodeOpeningLedger)
+	require.True(t, ok)
+	require.Equal(t, TypeCodeDefinition{Code: "010", Name: "Opening Ledger", Category: CategoryStatus, Direction: DirectionNone}, definition)
+	require.True(t, definition.AllowedIn(util.AccountIdentifierCode))
+	require.False(t, definition.AllowedIn(util.TransactionDetailCode))
+
+	definition, ok = LookupTypeCode("110")
+	require.True(t, ok)
+	require.Equal(t, "Total Lockbox Deposits", definition.Name)
+	require.Equal(t, CategorySummary, definition.Category)
+	require.Equal(t, DirectionCredit, definition.Direction)
+
+	definition, ok = LookupTypeCode(TypeCodeCheckPaid)
+	require.True(t, ok)
+	require.Equal(t, CategoryDetail, definition.Category)
+	require.Equal(t, DirectionDebit, definition.Direction)
+	require.False(t, definition.AllowedIn(util.AccountIdentifierCode))
+	require.True(t, definition.AllowedIn(util.TransactionDetailCode))
+
+	definition, ok = LookupTypeCode(TypeCodeNonMonetaryInformation)
+	require.True(t, ok)
+	require.Equal(t, CategoryDetail, definition.Category)
+	require.Equal(t, DirectionNone, definition.Direction)
+
+	definition, ok = LookupTypeCode("905")
+	require.True(t, ok)
+	require.True(t, definition.Custom)
+	require.Equal(t, CategoryStatus, definition.Category)
+
+	definition, ok = LookupTypeCode("930")
+	require.True(t, ok)
+	require.Equal(t, DirectionCredit, definition.Direction)
+	require.True(t, definition.AllowedIn(util.AccountIdentifierCode))
+	require.True(t, definition.AllowedIn(util.TransactionDetailCode))
+
+	definition, ok = LookupTypeCode("999")
+	require.True(t, ok)
+	require.Equal(t, DirectionDebit, definition.Direction)
+
+	for _, code := range []string{"046", "102", "800", "12", "abc", ""} {
+		_, ok = LookupTypeCode(code)
+		require.False(t, ok, code)
+	}
+}
+
+func TestTypeCodes(t *testing.T) {
+	codes := TypeCodes()
+	require.Len(t, codes, 469)
+	for i := range codes {
+		require.NotEmpty(t, codes[i].Name)
+		require.NotZero(t, codes[i].Category)
+		if i > 0 {
+			require.Less(t, codes[i-1].Code, codes[i].Code)
+		}
+		if codes[i].Category == CategoryStatus {
+			require.Equal(t, DirectionNone, codes[i].Direction, codes[i].Code)
+		}
+	}
+}
+
+func TestTypeCodeValidation(t *testing.T) {
+	detail := Detail{TypeCode: "100", Amount: "100"}
+	require.EqualError(t, detail.Validate(), "TransactionDetail: invalid TypeCode")
+
+	detail = Detail{TypeCode: "949", Amount: "100"}
+	require.NoError(t, detail.Validate())
+
+	identifier := accountIdentifier{
+		AccountNumber: "10200123456",
+		Summaries:     []AccountSummary{{TypeCode: TypeCodeCheckPaid, Amount: "100"}},
+	}
+	require.EqualError(t, identifier.validate(), "AccountIdentifierCurrent: invalid TypeCode")
+
+	// codes that are not assigned by the specification are accepted
+	identifier.Summaries = []AccountSummary{{TypeCode: "046", Amount: "100"}, {TypeCode: "949", Amount: "100"}}
+	require.NoError(t, identifier.validate())
+}
+
+func TestSumNetDetailAmounts_TypeCodeDirection(t *testing.T) {
+	account := Account{
+		AccountNumber: "9876543210",
+		Details: []Detail{
+			{TypeCode: TypeCodeLockboxDeposit, Amount: "1000"},
+			{TypeCode: "721", Amount: "200"}, // Amount Applied to Interest, a credit in the loan range
+			{TypeCode: "960", Amount: "100"},
+			{TypeCode: TypeCodeNonMonetaryInformation, Text: "detail reports will be delayed until 11:00 AM."},
+		},
+	}
+
+	sum, err := account.SumNetDetailAmounts()
+	require.NoError(t, err)
+	require.Equal(t, "1100", sum)
+}
@@ -0,0 +1,272 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/transform"
+)
+
+var errWriterClosed = errors.New("writer is closed")
+
+// WriterOption configures optional behavior of a Writer
+type WriterOption func(*Writer)
+
+// CRLF configures the writer to end lines with a carriage return and a line feed rather than a line feed
+func CRLF() WriterOption {
+	return func(w *Writer) {
+		w.lineEnding = "\r\n"
+	}
+}
+
+// TrailingNewline configures the writer to end the last record of the file with a line ending
+func TrailingNewline() WriterOption {
+	return func(w *Writer) {
+		w.trailingNewline = true
+	}
+}
+
+// FixedBlockOutput configures the writer to write physical records padded to the physical record length of the
+// file header and grouped into blocks of its block size. The line ending of the writer is used as the default
+// line terminator.
+func FixedBlockOutput(opts ...FixedBlockOption) WriterOption {
+	return func(w *Writer) {
+		w.fixedBlock = true
+		w.fixedBlockOpts = opts
+	}
+}
+
+// Writer writes the records of BAI2 files to an io.Writer as they are given, without holding the file in memory.
+// Records are split into continuation records according to the physical record length of the file header.
+//
+// Writes are buffered, and Close needs to be called once the file has been written.
+type Writer struct {
+	w       *bufio.Writer
+	encoder *transform.Writer
+	err     error
+
+	lineEnding      string
+	trailingNewline bool
+	fixedBlock      bool
+	fixedBlockOpts  []FixedBlockOption
+	encoding        encoding.Encoding
+
+	recordLength int64
+	records      int64
+	blocks       *fixedBlockWriter
+}
+
+// NewWriter returns a Writer writing to w, which ends lines with a line feed unless configured otherwise
+func NewWriter(w io.Writer, opts ...WriterOption) *Writer {
+	writer := &Writer{lineEnding: "\n"}
+	for _, opt := range opts {
+		opt(writer)
+	}
+
+	if writer.encoding != nil {
+		writer.encoder = transform.NewWriter(w, writer.encoding.NewEncoder())
+		w = writer.encoder
+	}
+	writer.w = bufio.NewWriter(w)
+
+	return writer
+}
+
+// Write writes the file with all its groups, accounts and details
+func (w *Writer) Write(file *Bai2) error {
+	if err := w.WriteEvent(&Event{Type: FileHeaderEvent, File: file}); err != nil {
+		return err
+	}
+
+	for i := range file.Groups {
+		group := &file.Groups[i]
+		if err := w.WriteEvent(&Event{Type: GroupHeaderEvent, Group: group}); err != nil {
+			return err
+		}
+
+		for j := range group.Accounts {
+			account := &group.Accounts[j]
+			if err := w.WriteEvent(&Event{Type: AccountIdentifierEvent, Account: account}); err != nil {
+				return err
+			}
+
+			for k := range account.Details {
+				if err := w.WriteEvent(&Event{Type: DetailEvent, Detail: &account.Details[k]}); err != nil {
+					return err
+				}
+			}
+
+			if err := w.WriteEvent(&Event{Type: AccountTrailerEvent, Account: account}); err != nil {
+				return err
+			}
+		}
+
+		if err := w.WriteEvent(&Event{Type: GroupTrailerEvent, Group: group}); err != nil {
+			return err
+		}
+	}
+
+	return w.WriteEvent(&Event{Type: FileTrailerEvent, File: file})
+}
+
+// WriteEvent writes the record of an event, such as the events returned by a Decoder. Envelopes are written
+// without their children, which are written by their own events.
+func (w *Writer) WriteEvent(event *Event) error {
+	if w.err != nil {
+		return w.err
+	}
+	if event == nil {
+		return errors.New("invalid event")
+	}
+
+	var records string
+	switch event.Type {
+	case FileHeaderEvent, FileTrailerEvent:
+		if event.File == nil {
+			return fmt.Errorf("%s event without file", event.Type)
+		}
+		file := *event.File
+		file.copyRecords()
+
+		if event.Type == FileHeaderEvent {
+			if err := w.startFile(&file); err != nil {
+				return err
+			}
+			records = file.header.string(w.recordLength)
+		} else {
+			records = file.trailer.string(w.recordLength)
+		}
+
+	case GroupHeaderEvent, GroupTrailerEvent:
+		if event.Group == nil {
+			return fmt.Errorf("%s event without group", event.Type)
+		}
+		// the accounts are written by their own events
+		group := *event.Group
+		group.Accounts = nil
+		group.copyRecords()
+
+		if event.Type == GroupHeaderEvent {
+			records = group.header.string(w.recordLength)
+		} else {
+			records = group.trailer.string(w.recordLength)
+		}
+
+	case AccountIdentifierEvent, AccountTrailerEvent:
+		if event.Account == nil {
+			return fmt.Errorf("%s event without account", event.Type)
+		}
+		account := *event.Account
+		account.copyRecords()
+
+		if event.Type == AccountIdentifierEvent {
+			records = account.header.string(w.recordLength)
+		} else {
+			records = account.trailer.string(w.recordLength)
+		}
+
+	case DetailEvent:
+		if event.Detail == nil {
+			return fmt.Errorf("%s event without detail", event.Type)
+		}
+		records = event.Detail.String(w.recordLength)
+
+	default:
+		return fmt.Errorf("unable to write %s event", event.Type)
+	}
+
+	for _, record := range strings.Split(records, "\n") {
+		if err := w.writeRecord(record); err != nil {
+			w.err = err
+			return err
+		}
+	}
+
+	if event.Type == FileTrailerEvent {
+		if err := w.endFile(); err != nil {
+			w.err = err
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Flush writes any buffered data to the underlying io.Writer. The encoder of OutputEncoding may hold back
+// data until the writer is closed.
+func (w *Writer) Flush() error {
+	if w.err != nil {
+		return w.err
+	}
+	return w.w.Flush()
+}
+
+// Close flushes the buffered data and closes the encoder of OutputEncoding, which writes the data it holds.
+// The underlying io.Writer is not closed. The Writer cannot be used after Close.
+func (w *Writer) Close() error {
+	err := w.Flush()
+	if err == nil && w.encoder != nil {
+		err = w.encoder.Close()
+	}
+
+	if err == nil {
+		w.err = errWriterClosed
+	} else if w.err == nil {
+		w.err = err
+	}
+	return err
+}
+
+func (w *Writer) startFile(file *Bai2) error {
+	w.recordLength = file.PhysicalRecordLength
+	if !w.fixedBlock {
+		return nil
+	}
+
+	opts := append([]FixedBlockOption{WithTerminator(w.lineEnding)}, w.fixedBlockOpts...)
+	blocks, err := newFixedBlockWriter(w.w, file.PhysicalRecordLength, file.BlockSize, opts...)
+	if err != nil {
+		return err
+	}
+	w.blocks = blocks
+	return nil
+}
+
+func (w *Writer) writeRecord(record string) error {
+	if w.blocks != nil {
+		return w.blocks.writeRecord(record)
+	}
+
+	if w.records > 0 && !w.trailingNewline {
+		if _, err := w.w.WriteString(w.lineEnding); err != nil {
+			return err
+		}
+	}
+	w.records++
+
+	if _, err := w.w.WriteString(record); err != nil {
+		return err
+	}
+	if w.trailingNewline {
+		_, err := w.w.WriteString(w.lineEnding)
+		return err
+	}
+	return nil
+}
+
+func (w *Writer) endFile() error {
+	if w.blocks != nil {
+		err := w.blocks.close()
+		w.blocks = nil
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lib
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/transform"
+)
+
+func TestWriter(t *testing.T) {
+	scan := NewBai2Scanner(strings.NewReader(multiCurrencySample))
+	file := NewBai2()
+	require.NoError(t, file.Read(&scan))
+
+	var buf bytes.Buffer
+	writer := NewWriter(&buf)
+	require.NoError(t, writer.Write(file))
+	require.NoError(t, writer.Flush())
+	require.Equal(t, file.String(), buf.String())
+
+	buf.Reset()
+	writer = NewWriter(&buf, CRLF(), TrailingNewline())
+	require.NoError(t, writer.Write(file))
+	require.NoError(t, writer.Flush())
+	require.Equal(t, strings.ReplaceAll(file.String(), "\n", "\r\n")+"\r\n", buf.String())
+
+	file.PhysicalRecordLength = 40
+	file.BlockSize = 3
+	expected, err := file.FixedBlock(WithTerminator(""), PadLastBlock())
+	require.NoError(t, err)
+
+	buf.Reset()
+	writer = NewWriter(&buf, FixedBlockOutput(WithTerminator(""), PadLastBlock()))
+	require.NoError(t, writer.Write(file))
+	require.NoError(t, writer.Flush())
+	require.Equal(t, expected, buf.String())
+}
+
+func TestWriterFromDecoder(t *testing.T) {
+	paths := []string{
+		"sample1.txt",
+		"sample2.txt",
+		"sample4-continuations-newline-delimited.txt",
+		"sample5-issue113.txt",
+	}
+
+	for _, path := range paths {
+		data, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", path))
+		require.NoError(t, err)
+
+		scan := NewBai2Scanner(bytes.NewReader(data))
+		file := NewBai2()
+		require.NoError(t, file.Read(&scan), path)
+
+		var buf bytes.Buffer
+		writer := NewWriter(&buf)
+
+		scan = NewBai2Scanner(bytes.NewReader(data))
+		decoder := NewDecoder(&scan)
+		for {
+			event, err := decoder.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err, path)
+			require.NoError(t, writer.WriteEvent(event), path)
+		}
+		require.NoError(t, writer.Flush(), path)
+
+		require.Equal(t, file.String(), buf.String(), path)
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func TestWriterErrors(t *testing.T) {
+	writer := NewWriter(&bytes.Buffer{})
+	require.EqualError(t, writer.WriteEvent(nil), "invalid event")
+	require.EqualError(t, writer.WriteEvent(&Event{Type: GroupHeaderEvent}), "GroupHeader event without group")
+	require.EqualError(t, writer.WriteEvent(&Event{Type: EventType(42)}), "unable to write EventType(42) event")
+
+	writer = NewWriter(&bytes.Buffer{}, FixedBlockOutput())
+	require.EqualError(t, writer.WriteEvent(&Event{Type: FileHeaderEvent, File: &Bai2{Sender: "0004"}}), "FixedBlock: physical record length is required")
+
+	writer = NewWriter(failingWriter{})
+	require.NoError(t, writer.WriteEvent(&Event{Type: FileHeaderEvent, File: &Bai2{Sender: "0004"}}))
+	require.EqualError(t, writer.Flush(), "write failed")
+}
+
+// holdingTransformer holds all data back until the end of the input
+type holdingTransformer struct{ transform.NopResetter }
+
+func (holdingTransformer) Transform(dst, src []byte, atEOF bool) (int, int, error) {
+	if !atEOF {
+		return 0, 0, transform.ErrShortSrc
+	}
+	n := copy(dst, src)
+	if n < len(src) {
+		return n, n, transform.ErrShortDst
+	}
+	return n, n, nil
+}
+
+type holdingEncoding struct{}
+
+func (holdingEncoding) NewDecoder() *encoding.Decoder {
+	return &encoding.Decoder{Transformer: holdingTransformer{}}
+}
+
+func (holdingEncoding) NewEncoder() *encoding.Encoder {
+	return &encoding.Encoder{Transformer: holdingTransformer{}}
+}
+
+func TestWriterClose(t *testing.T) {
+	file := &Bai2{Sender: "0004", Receiver: "12345", FileCreatedDate: "060321", FileCreatedTime: "0829", FileIdNumber: "001"}
+	require.NoError(t, file.Finalize())
+
+	var buf bytes.Buffer
+	writer := NewWriter(&buf, OutputEncoding(holdingEncoding{}))
+	require.NoError(t, writer.Write(file))
+	require.NoError(t, writer.Flush())
+	require.Empty(t, buf.String())
+
+	require.NoError(t, writer.Close())
+	require.Equal(t, file.String(), buf.String())
+
+	require.EqualError(t, writer.Write(file), "writer is closed")
+	require.EqualError(t, writer.Close(), "writer is closed")
+
+	writer = NewWriter(failingWriter{})
+	require.NoError(t, writer.WriteEvent(&Event{Type: FileHeaderEvent, File: file}))
+	require.EqualError(t, writer.Close(), "write failed")
+}
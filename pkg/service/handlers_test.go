@@ -162,7 +162,7 @@ func (suite *HandlersTest) TestFormat() {
 
 	suite.testServer.ServeHTTP(recorder, request)
 	assert.Equal(suite.T(), http.StatusOK, recorder.Code)
-	assert.Equal(suite.T(), recorder.Body.String(), `{"sender":"0004","receiver":"12345","fileCreatedDate":"060321","fileCreatedTime":"0829","fileIdNumber":"001","physicalRecordLength":80,"blockSize":1,"versionNumber":2,"fileControlTotal":"+00000000001280000","numberOfGroups":1,"numberOfRecords":27,"Groups":[{"receiver":"12345","originator":"0004","groupStatus":1,"asOfDate":"060317","currencyCode":"CAD","groupControlTotal":"+00000000001280000","numberOfAccounts":2,"numberOfRecords":25,"Accounts":[{"accountNumber":"10200123456","currencyCode":"CAD","summaries":[{"TypeCode":"040","Amount":"+000000000000","ItemCount":0,"FundsType":{}},{"TypeCode":"045","Amount":"+000000000000","ItemCount":0,"FundsType":{}},{"TypeCode":"100","Amount":"000000000208500","ItemCount":3,"FundsType":{"type_code":"V","date":"060316"}},{"TypeCode":"400","Amount":"000000000208500","ItemCount":8,"FundsType":{"type_code":"V","date":"060316"}}],"accountControlTotal":"+00000000000834000","numberRecords":14,"Details":[{"TypeCode":"409","Amount":"000000000002500","FundsType":{"type_code":"V","date":"060316"},"BankReferenceNumber":"","CustomerReferenceNumber":"","Text":"RETURNED CHEQUE     /"},{"TypeCode":"409","Amount":"000000000090000","FundsType":{"type_code":"V","date":"060316"},"BankReferenceNumber":"","CustomerReferenceNumber":"","Text":"RTN-UNKNOWN         /"},{"TypeCode":"409","Amount":"000000000000500","FundsType":{"type_code":"V","date":"060316"},"BankReferenceNumber":"","CustomerReferenceNumber":"","Text":"RTD CHQ SERVICE CHRG/"},{"TypeCode":"108","Amount":"000000000203500","FundsType":{"type_code":"V","date":"060316"},"BankReferenceNumber":"","CustomerReferenceNumber":"","Text":"TFR 1020 0345678    /"},{"TypeCode":"108","Amount":"000000000002500","FundsType":{"type_code":"V","date":"060316"},"BankReferenceNumber":"","CustomerReferenceNumber":"","Text":"MACLEOD MALL        /"},{"TypeCode":"108","Amount":"000000000002500","FundsType":{"type_code":"V","date":"060316"},"BankReferenceNumber":"","CustomerReferenceNumber":"","Text":"MASCOUCHE QUE       /"},{"TypeCode":"409","Amount":"000000000020000","FundsType":{"type_code":"V","date":"060316"},"BankReferenceNumber":"","CustomerReferenceNumber":"","Text":"1000 ISLANDS MALL   /"},{"TypeCode":"409","Amount":"000000000090000","FundsType":{"type_code":"V","date":"060316"},"BankReferenceNumber":"","CustomerReferenceNumber":"","Text":"PENHORA MALL        /"},{"TypeCode":"409","Amount":"000000000002000","FundsType":{"type_code":"V","date":"060316"},"BankReferenceNumber":"","CustomerReferenceNumber":"","Text":"CAPILANO MALL       /"},{"TypeCode":"409","Amount":"000000000002500","FundsType":{"type_code":"V","date":"060316"},"BankReferenceNumber":"","CustomerReferenceNumber":"","Text":"GALERIES LA CAPITALE/"},{"TypeCode":"409","Amount":"000000000001000","FundsType":{"type_code":"V","date":"060316"},"BankReferenceNumber":"","CustomerReferenceNumber":"","Text":"PLAZA ROCK FOREST   /"}]},{"accountNumber":"10200123456","currencyCode":"CAD","summaries":[{"TypeCode":"040","Amount":"+000000000000","ItemCount":0,"FundsType":{}},{"TypeCode":"045","Amount":"+000000000000","ItemCount":0,"FundsType":{}},{"TypeCode":"100","Amount":"000000000111500","ItemCount":2,"FundsType":{"type_code":"V","date":"060317"}},{"TypeCode":"400","Amount":"000000000111500","ItemCount":4,"FundsType":{"type_code":"V","date":"060317"}}],"accountControlTotal":"+00000000000446000","numberRecords":9,"Details":[{"TypeCode":"108","Amount":"000000000011500","FundsType":{"type_code":"V","date":"060317"},"BankReferenceNumber":"","CustomerReferenceNumber":"","Text":"TFR 1020 0345678    /"},{"TypeCode":"108","Amount":"000000000100000","FundsType":{"type_code":"V","date":"060317"},"BankReferenceNumber":"","CustomerReferenceNumber":"","Text":"MONTREAL            /"},{"TypeCode":"409","Amount":"000000000100000","FundsType":{"type_code":"V","date":"060317"},"BankReferenceNumber":"","CustomerReferenceNumber":"","Text":"GRANDFALL NB        /"},{"TypeCode":"409","Amount":"000000000009000","FundsType":{"type_code":"V","date":"060317"},"BankReferenceNumber":"","CustomerReferenceNumber":"","Text":"HAMILTON ON         /"},{"TypeCode":"409","Amount":"000000000002000","FundsType":{"type_code":"V","date":"060317"},"BankReferenceNumber":"","CustomerReferenceNumber":"","Text":"WOODSTOCK NB        /"},{"TypeCode":"409","Amount":"000000000000500","FundsType":{"type_code":"V","date":"060317"},"BankReferenceNumber":"","CustomerReferenceNumber":"","Text":"GALERIES RICHELIEU  /"}]}]}]}
+	assert.Equal(suite.T(), recorder.Body.String(), `{"sender":"0004","receiver":"12345","fileCreatedDate":"060321","fileCreatedTime":"0829","fileIdNumber":"001","physicalRecordLength":80,"blockSize":1,"versionNumber":2,"fileControlTotal":"+00000000001280000","numberOfGroups":1,"numberOfRecords":27,"Groups":[{"receiver":"12345","originator":"0004","groupStatus":1,"asOfDate":"060317","currencyCode":"CAD","groupControlTotal":"+00000000001280000","numberOfAccounts":2,"numberOfRecords":25,"Accounts":[{"accountNumber":"10200123456","currencyCode":"CAD","summaries":[{"TypeCode":"040","Amount":"+000000000000","money":{"amount":"0.00","currency":"CAD"},"ItemCount":0,"FundsType":{}},{"TypeCode":"045","Amount":"+000000000000","money":{"amount":"0.00","currency":"CAD"},"ItemCount":0,"FundsType":{}},{"TypeCode":"100","Amount":"000000000208500","money":{"amount":"2085.00","currency":"CAD"},"ItemCount":3,"FundsType":{"type_code":"V","date":"060316"}},{"TypeCode":"400","Amount":"000000000208500","money":{"amount":"2085.00","currency":"CAD"},"ItemCount":8,"FundsType":{"type_code":"V","date":"060316"}}],"accountControlTotal":"+00000000000834000","numberRecords":14,"Details":[{"TypeCode":"409","Amount":"000000000002500","money":{"amount":"25.00","currency":"CAD"},"FundsType":{"type_code":"V","date":"060316"},"BankReferenceNumber":"","CustomerReferenceNumber":"","Text":"RETURNED CHEQUE     /"},{"TypeCode":"409","Amount":"000000000090000","money":{"amount":"900.00","currency":"CAD"},"FundsType":{"type_code":"V","date":"060316"},"BankReferenceNumber":"","CustomerReferenceNumber":"","Text":"RTN-UNKNOWN         /"},{"TypeCode":"409","Amount":"000000000000500","money":{"amount":"5.00","currency":"CAD"},"FundsType":{"type_code":"V","date":"060316"},"BankReferenceNumber":"","CustomerReferenceNumber":"","Text":"RTD CHQ SERVICE CHRG/"},{"TypeCode":"108","Amount":"000000000203500","money":{"amount":"2035.00","currency":"CAD"},"FundsType":{"type_code":"V","date":"060316"},"BankReferenceNumber":"","CustomerReferenceNumber":"","Text":"TFR 1020 0345678    /"},{"TypeCode":"108","Amount":"000000000002500","money":{"amount":"25.00","currency":"CAD"},"FundsType":{"type_code":"V","date":"060316"},"BankReferenceNumber":"","CustomerReferenceNumber":"","Text":"MACLEOD MALL        /"},{"TypeCode":"108","Amount":"000000000002500","money":{"amount":"25.00","currency":"CAD"},"FundsType":{"type_code":"V","date":"060316"},"BankReferenceNumber":"","CustomerReferenceNumber":"","Text":"MASCOUCHE QUE       /"},{"TypeCode":"409","Amount":"000000000020000","money":{"amount":"200.00","currency":"CAD"},"FundsType":{"type_code":"V","date":"060316"},"BankReferenceNumber":"","CustomerReferenceNumber":"","Text":"1000 ISLANDS MALL   /"},{"TypeCode":"409","Amount":"000000000090000","money":{"amount":"900.00","currency":"CAD"},"FundsType":{"type_code":"V","date":"060316"},"BankReferenceNumber":"","CustomerReferenceNumber":"","Text":"PENHORA MALL        /"},{"TypeCode":"409","Amount":"000000000002000","money":{"amount":"20.00","currency":"CAD"},"FundsType":{"type_code":"V","date":"060316"},"BankReferenceNumber":"","CustomerReferenceNumber":"","Text":"CAPILANO MALL       /"},{"TypeCode":"409","Amount":"000000000002500","money":{"amount":"25.00","currency":"CAD"},"FundsType":{"type_code":"V","date":"060316"},"BankReferenceNumber":"","CustomerReferenceNumber":"","Text":"GALERIES LA CAPITALE/"},{"TypeCode":"409","Amount":"000000000001000","money":{"amount":"10.00","currency":"CAD"},"FundsType":{"type_code":"V","date":"060316"},"BankReferenceNumber":"","CustomerReferenceNumber":"","Text":"PLAZA ROCK FOREST   /"}]},{"accountNumber":"10200123456","currencyCode":"CAD","summaries":[{"TypeCode":"040","Amount":"+000000000000","money":{"amount":"0.00","currency":"CAD"},"ItemCount":0,"FundsType":{}},{"TypeCode":"045","Amount":"+000000000000","money":{"amount":"0.00","currency":"CAD"},"ItemCount":0,"FundsType":{}},{"TypeCode":"100","Amount":"000000000111500","money":{"amount":"1115.00","currency":"CAD"},"ItemCount":2,"FundsType":{"type_code":"V","date":"060317"}},{"TypeCode":"400","Amount":"000000000111500","money":{"amount":"1115.00","currency":"CAD"},"ItemCount":4,"FundsType":{"type_code":"V","date":"060317"}}],"accountControlTotal":"+00000000000446000","numberRecords":9,"Details":[{"TypeCode":"108","Amount":"000000000011500","money":{"amount":"115.00","currency":"CAD"},"FundsType":{"type_code":"V","date":"060317"},"BankReferenceNumber":"","CustomerReferenceNumber":"","Text":"TFR 1020 0345678    /"},{"TypeCode":"108","Amount":"000000000100000","money":{"amount":"1000.00","currency":"CAD"},"FundsType":{"type_code":"V","date":"060317"},"BankReferenceNumber":"","CustomerReferenceNumber":"","Text":"MONTREAL            /"},{"TypeCode":"409","Amount":"000000000100000","money":{"amount":"1000.00","currency":"CAD"},"FundsType":{"type_code":"V","date":"060317"},"BankReferenceNumber":"","CustomerReferenceNumber":"","Text":"GRANDFALL NB        /"},{"TypeCode":"409","Amount":"000000000009000","money":{"amount":"90.00","currency":"CAD"},"FundsType":{"type_code":"V","date":"060317"},"BankReferenceNumber":"","CustomerReferenceNumber":"","Text":"HAMILTON ON         /"},{"TypeCode":"409","Amount":"000000000002000","money":{"amount":"20.00","currency":"CAD"},"FundsType":{"type_code":"V","date":"060317"},"BankReferenceNumber":"","CustomerReferenceNumber":"","Text":"WOODSTOCK NB        /"},{"TypeCode":"409","Amount":"000000000000500","money":{"amount":"5.00","currency":"CAD"},"FundsType":{"type_code":"V","date":"060317"},"BankReferenceNumber":"","CustomerReferenceNumber":"","Text":"GALERIES RICHELIEU  /"}]}]}]}
 `)
 }
 
@@ -223,7 +223,7 @@ func (suite *HandlersTest) TestFormat_Bai2FileWithNewlineDelimitedContinuations(
 
 	suite.testServer.ServeHTTP(recorder, request)
 	assert.Equal(suite.T(), http.StatusOK, recorder.Code)
-	assert.Equal(suite.T(), recorder.Body.String(), `{"sender":"GSBI","receiver":"cont001","fileCreatedDate":"210706","fileCreatedTime":"1249","fileIdNumber":"1","versionNumber":2,"fileControlTotal":"13060195162","numberOfGroups":1,"numberOfRecords":18,"Groups":[{"receiver":"cont001","originator":"026015079","groupStatus":1,"asOfDate":"230906","asOfTime":"2000","groupControlTotal":"13060195162","numberOfAccounts":4,"numberOfRecords":16,"Accounts":[{"accountNumber":"107049924","currencyCode":"USD","summaries":[{"TypeCode":"","Amount":"","ItemCount":0,"FundsType":{}},{"TypeCode":"060","Amount":"13053325440","ItemCount":0,"FundsType":{}},{"TypeCode":"100","Amount":"000","ItemCount":0,"FundsType":{}},{"TypeCode":"400","Amount":"000","ItemCount":0,"FundsType":{}}],"accountControlTotal":"13053325440","numberRecords":2,"Details":null},{"accountNumber":"107049932","currencyCode":"USD","summaries":[{"TypeCode":"","Amount":"","ItemCount":0,"FundsType":{}},{"TypeCode":"060","Amount":"6865898","ItemCount":0,"FundsType":{}},{"TypeCode":"100","Amount":"1912","ItemCount":1,"FundsType":{}},{"TypeCode":"400","Amount":"000","ItemCount":0,"FundsType":{}}],"accountControlTotal":"-1260161341762","numberRecords":26,"Details":[{"TypeCode":"447","Amount":"60000","FundsType":{},"BankReferenceNumber":"SPB2322984714570","CustomerReferenceNumber":"1111","Text":"ACH Credit Payment,Entry Description: EXP; -, SEC: CCD, Client Ref ID: 1111, GS ID: SPB2322984714570,EREF: 1111,DBNM: TEST INC,CACT: ACHCONTROLOUTUSD01/"},{"TypeCode":"261","Amount":"143500","FundsType":{},"BankReferenceNumber":"SB2322600000404","CustomerReferenceNumber":"GSQ4FBGFDGWGKY","Text":"ACH Credit Reject,From: TEST INC, Remittance Info: \"ACH- Test - Addenda Record\", Entry Description: TRADE; -, SEC: CTX, Client Ref ID: GSQ4FBGFDGWGKY, GS ID: SB2322600000404,CREF: ,REMI: ACH- Test - Addenda Record,EREF: GSQ4FBGFDGWGKY,CRNM: Test,DBNM: SAMPLE INC,DACT: 101152046,DABA: 026015079/"},{"TypeCode":"447","Amount":"928650","FundsType":{},"BankReferenceNumber":"SPB2322684598521","CustomerReferenceNumber":"AB-GS-RPFILERP0001-RPBA0001","Text":"ACH Credit Payment,Entry Description: TRADE; -, SEC: CTX, Client Ref ID: AB-GS-TEST0001-RPBA0001, GS ID: SPB2322684598521,EREF: AB-GS-RPFILERP0001-RPBA0001,DBNM: SAMPLE INC,CACT: ACHCONTROLOUTUSD01/"}]},{"accountNumber":"104108339","currencyCode":"USD","summaries":[{"TypeCode":"010","Amount":"159581194","ItemCount":0,"FundsType":{}},{"TypeCode":"015","Amount":"159381194","ItemCount":0,"FundsType":{}},{"TypeCode":"040","Amount":"158568897","ItemCount":0,"FundsType":{}},{"TypeCode":"045","Amount":"158368897","ItemCount":0,"FundsType":{}},{"TypeCode":"100","Amount":"000","ItemCount":0,"FundsType":{}},{"TypeCode":"400","Amount":"200000","ItemCount":1,"FundsType":{}}],"accountControlTotal":"6869722","numberRecords":8,"Details":[{"TypeCode":"557","Amount":"200000","FundsType":{},"BankReferenceNumber":"SB2322600000214","CustomerReferenceNumber":"021000080000030","Text":"ACH Credit Receipt Return,Return To: Test, Remittance Info: \"SB2322300000052\", Entry Description: EXP; -, SEC: CCD, Reason: \"R02\", Return of Client Ref ID: 021000080000030, GS ID: SB2322600000214,CREF: 026015076104300,IDNM: 1114,EREF: 021000080000030,CRNM: Test,DBNM: SAMPLE INC.,CABA: 021000089/"},{"TypeCode":"451","Amount":"55555","FundsType":{},"BankReferenceNumber":"SB2322600000455","CustomerReferenceNumber":"021000020000021","Text":"ACH Debit Payment,To: TEST, Entry Description: INVOICES; 210630, SEC: CCD, Client Ref ID: 021000020000021, GS ID: SB2322600000455,CREF: 021000020000021,IDNM: 2009282,EREF: 021000020000021,CRNM: TEST,DBNM: SAMPLE INC,CABA: 021000021/"},{"TypeCode":"266","Amount":"1912","FundsType":{},"BankReferenceNumber":"GI2118700002010","CustomerReferenceNumber":"20210706MMQFMPU8000001","Text":"Outgoing Wire Return,-,CREF: 20210706MMQFMPU8000001,EREF: 20210706MMQFMPU8000001,DBIC: GSCRUS33,CRNM: ABC Company,DBNM: SAMPLE INC./"},{"TypeCode":"495","Amount":"50500","FundsType":{},"BankReferenceNumber":"GI2321400000090","CustomerReferenceNumber":"GSV0DL6RKT","Text":"Outgoing Wire,To: TEST COMPANY, Remittance Info: \"QWERTIOP\", Client Ref ID: GSV0DL6RKT, GS ID: GI2321400000090, Settled Amt: EUR 322.00, FX Rate: 156.833677,REMI: QWERTIOP,EREF: GSV0DL6RKT,CBIC: COBADEFF,CRNM: TEST COMPANY,DBNM: SAMPLE TEST/"},{"TypeCode":"195","Amount":"1125","FundsType":{},"BankReferenceNumber":"GI2229300000187","CustomerReferenceNumber":"GS0D9VGMP1IWPLW","Text":"Incoming Wire,-,EREF: GS0D9VGMP1IWPLW,DBIC: CITIUS30XXX,CRNM: ABC CORPORATION,DACT: 8348572423,CHKN: GSIL2X6103UNCRSF/"},{"TypeCode":"257","Amount":"60000","FundsType":{},"BankReferenceNumber":"SB2225800001203","CustomerReferenceNumber":"028000020000335","Text":"ACH Debit Payment Return,Return From: Company1, Entry Description: TRADE; -, SEC: CCD, Reason: \"R02\", Return of Client Ref ID: 028000020000335, GS ID: SB2225800001203,IDNM: 1,EREF: 028000020000335,CRNM: TEST INC,DBNM: Company1,DABA: 028000024/"},{"TypeCode":"255","Amount":"931","FundsType":{},"BankReferenceNumber":"SC2134800001999","CustomerReferenceNumber":"","Text":"Check Return,Return From: Test2 Customer, Check Serial Number: 0009000000, Return Reason: \"Payee does not exist\", Client Ref ID: 74564762445, GS ID: SC213480000120999,EREF: 07370568132,CRNM: Test Inc.,DBNM: Test2 Customer,CABA: 12345,CHKN: 0009000000/"},{"TypeCode":"195","Amount":"50050","FundsType":{},"BankReferenceNumber":"GI2228400005800","CustomerReferenceNumber":"RTR60880840833","Text":"RTP Incoming,From: SAMPLE INC, Remittance Info: \"Test Remittance\", Client Ref ID: RTR60880840833, GS ID: GI2228400005800, Clearing Ref: 001,REMI: Test Remittance,EREF: RTR60880840833,CRNM: RTR-CdtrName,DBNM: SAMPLE INC,DACT: 02122056789012205,DABA: 000000010/"},{"TypeCode":"175","Amount":"527","FundsType":{},"BankReferenceNumber":"SX22293073766088","CustomerReferenceNumber":"GS4N04L1COP45VY","Text":"Check Deposit,-,EREF: GS4N04L1COP45VY,DACT: 100168723/"},{"TypeCode":"475","Amount":"10100","FundsType":{},"BankReferenceNumber":"SC2229300000152","CustomerReferenceNumber":"01030340329","Text":"Check Paid,-,REMI: UAT testing for Checks,EREF: 01030340329,CRNM: TEST INC,DBNM: ABC CORP,CABA: 12345,CHKN: 006034594478/"},{"TypeCode":"275","Amount":"337686","FundsType":{},"BankReferenceNumber":"GI2318000014342","CustomerReferenceNumber":"e457328416d411eeaf020a58a9feac02","Text":"Cash Concentration,From: SAMPLE INC, Account: 290000020437, GS Cash Concentration, \"Structure ID: CC0000000\", GS ID: GI2318000212121,REMI: Structure ID: CC0000082,EREF: e123456786d411eeaf020a58a9feac02,DBIC: GSCRUS33VIA,CRNM: SAMPLE INC,DBNM: SAMPLE INC,DACT: 290000020437/"},{"TypeCode":"165","Amount":"5000","FundsType":{},"BankReferenceNumber":"SPB2321284264201","CustomerReferenceNumber":"AB-GS-DDFILEAB0001-DDBAB0001","Text":"ACH Debit Collection,Entry Description: BILL PMT; -, SEC: CCD, Client Ref ID: AB-GS-DDFILEAB0001-DDBAB0001, GS ID: SPB2321284264201,EREF: AB-GS-DDFILEAB0001-DDBAB0001,CRNM: SAMPLE LLP,DACT: ACHCONTROLINUSD01/"},{"TypeCode":"475","Amount":"44250","FundsType":{},"BankReferenceNumber":"SC2323300002416","CustomerReferenceNumber":"8ce1829175a74ec88d67010dd7fb6132","Text":"Check Paid,To: TEST AND COMPANY LLC, Check Serial Number: 24108, GS ID: SC2323300002416,EREF: 8ce1829175a74ec88d67010dd7fb6132,CRNM: TEST AND COMPANY LLC,DBNM: Sample Inc.,CABA: 0,CHKN: 24108/"},{"TypeCode":"495","Amount":"30000000","FundsType":{},"BankReferenceNumber":"GI2323300009168","CustomerReferenceNumber":"3785726","Text":"Outgoing Wire,To: TEST AND COMPANY, Remittance Info: \"081823 Invoice - Sample\", Client Ref ID: 3785726, GS ID: GI2323300009168, Clearing Ref: 20230821MMQFMPU7004100,CREF: 20230821MMQFMPU7004100,REMI: 081823 Invoice - Sample,EREF: 3785726,CRNM: TEST AND COMPANY,DBNM: Sample Inc.,CACT: 609873838,CABA: 021000021/"}]},{"accountNumber":"260000033037","currencyCode":"USD","summaries":[{"TypeCode":"","Amount":"","ItemCount":0,"FundsType":{}},{"TypeCode":"060","Amount":"000","ItemCount":0,"FundsType":{}},{"TypeCode":"100","Amount":"000","ItemCount":0,"FundsType":{}},{"TypeCode":"400","Amount":"000","ItemCount":0,"FundsType":{}}],"accountControlTotal":"000","numberRecords":2,"Details":null},{"accountNumber":"280000010657","currencyCode":"USD","summaries":[{"TypeCode":"","Amount":"","ItemCount":0,"FundsType":{}},{"TypeCode":"060","Amount":"000","ItemCount":0,"FundsType":{}},{"TypeCode":"100","Amount":"000","ItemCount":0,"FundsType":{}},{"TypeCode":"400","Amount":"000","ItemCount":0,"FundsType":{}}],"accountControlTotal":"000","numberRecords":2,"Details":null}]}]}
+	assert.Equal(suite.T(), recorder.Body.String(), `{"sender":"GSBI","receiver":"cont001","fileCreatedDate":"210706","fileCreatedTime":"1249","fileIdNumber":"1","versionNumber":2,"fileControlTotal":"13060195162","numberOfGroups":1,"numberOfRecords":18,"Groups":[{"receiver":"cont001","originator":"026015079","groupStatus":1,"asOfDate":"230906","asOfTime":"2000","groupControlTotal":"13060195162","numberOfAccounts":4,"numberOfRecords":16,"Accounts":[{"accountNumber":"107049924","currencyCode":"USD","summaries":[{"TypeCode":"","Amount":"","ItemCount":0,"FundsType":{}},{"TypeCode":"060","Amount":"13053325440","money":{"amount":"130533254.40","currency":"USD"},"ItemCount":0,"FundsType":{}},{"TypeCode":"100","Amount":"000","money":{"amount":"0.00","currency":"USD"},"ItemCount":0,"FundsType":{}},{"TypeCode":"400","Amount":"000","money":{"amount":"0.00","currency":"USD"},"ItemCount":0,"FundsType":{}}],"accountControlTotal":"13053325440","numberRecords":2,"Details":null},{"accountNumber":"107049932","currencyCode":"USD","summaries":[{"TypeCode":"","Amount":"","ItemCount":0,"FundsType":{}},{"TypeCode":"060","Amount":"6865898","money":{"amount":"68658.98","currency":"USD"},"ItemCount":0,"FundsType":{}},{"TypeCode":"100","Amount":"1912","money":{"amount":"19.12","currency":"USD"},"ItemCount":1,"FundsType":{}},{"TypeCode":"400","Amount":"000","money":{"amount":"0.00","currency":"USD"},"ItemCount":0,"FundsType":{}}],"accountControlTotal":"-1260161341762","numberRecords":26,"Details":[{"TypeCode":"447","Amount":"60000","money":{"amount":"600.00","currency":"USD"},"FundsType":{},"BankReferenceNumber":"SPB2322984714570","CustomerReferenceNumber":"1111","Text":"ACH Credit Payment,Entry Description: EXP; -, SEC: CCD, Client Ref ID: 1111, GS ID: SPB2322984714570,EREF: 1111,DBNM: TEST INC,CACT: ACHCONTROLOUTUSD01/"},{"TypeCode":"261","Amount":"143500","money":{"amount":"1435.00","currency":"USD"},"FundsType":{},"BankReferenceNumber":"SB2322600000404","CustomerReferenceNumber":"GSQ4FBGFDGWGKY","Text":"ACH Credit Reject,From: TEST INC, Remittance Info: \"ACH- Test - Addenda Record\", Entry Description: TRADE; -, SEC: CTX, Client Ref ID: GSQ4FBGFDGWGKY, GS ID: SB2322600000404,CREF: ,REMI: ACH- Test - Addenda Record,EREF: GSQ4FBGFDGWGKY,CRNM: Test,DBNM: SAMPLE INC,DACT: 101152046,DABA: 026015079/"},{"TypeCode":"447","Amount":"928650","money":{"amount":"9286.50","currency":"USD"},"FundsType":{},"BankReferenceNumber":"SPB2322684598521","CustomerReferenceNumber":"AB-GS-RPFILERP0001-RPBA0001","Text":"ACH Credit Payment,Entry Description: TRADE; -, SEC: CTX, Client Ref ID: AB-GS-TEST0001-RPBA0001, GS ID: SPB2322684598521,EREF: AB-GS-RPFILERP0001-RPBA0001,DBNM: SAMPLE INC,CACT: ACHCONTROLOUTUSD01/"}]},{"accountNumber":"104108339","currencyCode":"USD","summaries":[{"TypeCode":"010","Amount":"159581194","money":{"amount":"1595811.94","currency":"USD"},"ItemCount":0,"FundsType":{}},{"TypeCode":"015","Amount":"159381194","money":{"amount":"1593811.94","currency":"USD"},"ItemCount":0,"FundsType":{}},{"TypeCode":"040","Amount":"158568897","money":{"amount":"1585688.97","currency":"USD"},"ItemCount":0,"FundsType":{}},{"TypeCode":"045","Amount":"158368897","money":{"amount":"1583688.97","currency":"USD"},"ItemCount":0,"FundsType":{}},{"TypeCode":"100","Amount":"000","money":{"amount":"0.00","currency":"USD"},"ItemCount":0,"FundsType":{}},{"TypeCode":"400","Amount":"200000","money":{"amount":"2000.00","currency":"USD"},"ItemCount":1,"FundsType":{}}],"accountControlTotal":"6869722","numberRecords":8,"Details":[{"TypeCode":"557","Amount":"200000","money":{"amount":"2000.00","currency":"USD"},"FundsType":{},"BankReferenceNumber":"SB2322600000214","CustomerReferenceNumber":"021000080000030","Text":"ACH Credit Receipt Return,Return To: Test, Remittance Info: \"SB2322300000052\", Entry Description: EXP; -, SEC: CCD, Reason: \"R02\", Return of Client Ref ID: 021000080000030, GS ID: SB2322600000214,CREF: 026015076104300,IDNM: 1114,EREF: 021000080000030,CRNM: Test,DBNM: SAMPLE INC.,CABA: 021000089/"},{"TypeCode":"451","Amount":"55555","money":{"amount":"555.55","currency":"USD"},"FundsType":{},"BankReferenceNumber":"SB2322600000455","CustomerReferenceNumber":"021000020000021","Text":"ACH Debit Payment,To: TEST, Entry Description: INVOICES; 210630, SEC: CCD, Client Ref ID: 021000020000021, GS ID: SB2322600000455,CREF: 021000020000021,IDNM: 2009282,EREF: 021000020000021,CRNM: TEST,DBNM: SAMPLE INC,CABA: 021000021/"},{"TypeCode":"266","Amount":"1912","money":{"amount":"19.12","currency":"USD"},"FundsType":{},"BankReferenceNumber":"GI2118700002010","CustomerReferenceNumber":"20210706MMQFMPU8000001","Text":"Outgoing Wire Return,-,CREF: 20210706MMQFMPU8000001,EREF: 20210706MMQFMPU8000001,DBIC: GSCRUS33,CRNM: ABC Company,DBNM: SAMPLE INC./"},{"TypeCode":"495","Amount":"50500","money":{"amount":"505.00","currency":"USD"},"FundsType":{},"BankReferenceNumber":"GI2321400000090","CustomerReferenceNumber":"GSV0DL6RKT","Text":"Outgoing Wire,To: TEST COMPANY, Remittance Info: \"QWERTIOP\", Client Ref ID: GSV0DL6RKT, GS ID: GI2321400000090, Settled Amt: EUR 322.00, FX Rate: 156.833677,REMI: QWERTIOP,EREF: GSV0DL6RKT,CBIC: COBADEFF,CRNM: TEST COMPANY,DBNM: SAMPLE TEST/"},{"TypeCode":"195","Amount":"1125","money":{"amount":"11.25","currency":"USD"},"FundsType":{},"BankReferenceNumber":"GI2229300000187","CustomerReferenceNumber":"GS0D9VGMP1IWPLW","Text":"Incoming Wire,-,EREF: GS0D9VGMP1IWPLW,DBIC: CITIUS30XXX,CRNM: ABC CORPORATION,DACT: 8348572423,CHKN: GSIL2X6103UNCRSF/"},{"TypeCode":"257","Amount":"60000","money":{"amount":"600.00","currency":"USD"},"FundsType":{},"BankReferenceNumber":"SB2225800001203","CustomerReferenceNumber":"028000020000335","Text":"ACH Debit Payment Return,Return From: Company1, Entry Description: TRADE; -, SEC: CCD, Reason: \"R02\", Return of Client Ref ID: 028000020000335, GS ID: SB2225800001203,IDNM: 1,EREF: 028000020000335,CRNM: TEST INC,DBNM: Company1,DABA: 028000024/"},{"TypeCode":"255","Amount":"931","money":{"amount":"9.31","currency":"USD"},"FundsType":{},"BankReferenceNumber":"SC2134800001999","CustomerReferenceNumber":"","Text":"Check Return,Return From: Test2 Customer, Check Serial Number: 0009000000, Return Reason: \"Payee does not exist\", Client Ref ID: 74564762445, GS ID: SC213480000120999,EREF: 07370568132,CRNM: Test Inc.,DBNM: Test2 Customer,CABA: 12345,CHKN: 0009000000/"},{"TypeCode":"195","Amount":"50050","money":{"amount":"500.50","currency":"USD"},"FundsType":{},"BankReferenceNumber":"GI2228400005800","CustomerReferenceNumber":"RTR60880840833","Text":"RTP Incoming,From: SAMPLE INC, Remittance Info: \"Test Remittance\", Client Ref ID: RTR60880840833, GS ID: GI2228400005800, Clearing Ref: 001,REMI: Test Remittance,EREF: RTR60880840833,CRNM: RTR-CdtrName,DBNM: SAMPLE INC,DACT: 02122056789012205,DABA: 000000010/"},{"TypeCode":"175","Amount":"527","money":{"amount":"5.27","currency":"USD"},"FundsType":{},"BankReferenceNumber":"SX22293073766088","CustomerReferenceNumber":"GS4N04L1COP45VY","Text":"Check Deposit,-,EREF: GS4N04L1COP45VY,DACT: 100168723/"},{"TypeCode":"475","Amount":"10100","money":{"amount":"101.00","currency":"USD"},"FundsType":{},"BankReferenceNumber":"SC2229300000152","CustomerReferenceNumber":"01030340329","Text":"Check Paid,-,REMI: UAT testing for Checks,EREF: 01030340329,CRNM: TEST INC,DBNM: ABC CORP,CABA: 12345,CHKN: 006034594478/"},{"TypeCode":"275","Amount":"337686","money":{"amount":"3376.86","currency":"USD"},"FundsType":{},"BankReferenceNumber":"GI2318000014342","CustomerReferenceNumber":"e457328416d411eeaf020a58a9feac02","Text":"Cash Concentration,From: SAMPLE INC, Account: 290000020437, GS Cash Concentration, \"Structure ID: CC0000000\", GS ID: GI2318000212121,REMI: Structure ID: CC0000082,EREF: e123456786d411eeaf020a58a9feac02,DBIC: GSCRUS33VIA,CRNM: SAMPLE INC,DBNM: SAMPLE INC,DACT: 290000020437/"},{"TypeCode":"165","Amount":"5000","money":{"amount":"50.00","currency":"USD"},"FundsType":{},"BankReferenceNumber":"SPB2321284264201","CustomerReferenceNumber":"AB-GS-DDFILEAB0001-DDBAB0001","Text":"ACH Debit Collection,Entry Description: BILL PMT; -, SEC: CCD, Client Ref ID: AB-GS-DDFILEAB0001-DDBAB0001, GS ID: SPB2321284264201,EREF: AB-GS-DDFILEAB0001-DDBAB0001,CRNM: SAMPLE LLP,DACT: ACHCONTROLINUSD01/"},{"TypeCode":"475","Amount":"44250","money":{"amount":"442.50","currency":"USD"},"FundsType":{},"BankReferenceNumber":"SC2323300002416","CustomerReferenceNumber":"8ce1829175a74ec88d67010dd7fb6132","Text":"Check Paid,To: TEST AND COMPANY LLC, Check Serial Number: 24108, GS ID: SC2323300002416,EREF: 8ce1829175a74ec88d67010dd7fb6132,CRNM: TEST AND COMPANY LLC,DBNM: Sample Inc.,CABA: 0,CHKN: 24108/"},{"TypeCode":"495","Amount":"30000000","money":{"amount":"300000.00","currency":"USD"},"FundsType":{},"BankReferenceNumber":"GI2323300009168","CustomerReferenceNumber":"3785726","Text":"Outgoing Wire,To: TEST AND COMPANY, Remittance Info: \"081823 Invoice - Sample\", Client Ref ID: 3785726, GS ID: GI2323300009168, Clearing Ref: 20230821MMQFMPU7004100,CREF: 20230821MMQFMPU7004100,REMI: 081823 Invoice - Sample,EREF: 3785726,CRNM: TEST AND COMPANY,DBNM: Sample Inc.,CACT: 609873838,CABA: 021000021/"}]},{"accountNumber":"260000033037","currencyCode":"USD","summaries":[{"TypeCode":"","Amount":"","ItemCount":0,"FundsType":{}},{"TypeCode":"060","Amount":"000","money":{"amount":"0.00","currency":"USD"},"ItemCount":0,"FundsType":{}},{"TypeCode":"100","Amount":"000","money":{"amount":"0.00","currency":"USD"},"ItemCount":0,"FundsType":{}},{"TypeCode":"400","Amount":"000","money":{"amount":"0.00","currency":"USD"},"ItemCount":0,"FundsType":{}}],"accountControlTotal":"000","numberRecords":2,"Details":null},{"accountNumber":"280000010657","currencyCode":"USD","summaries":[{"TypeCode":"","Amount":"","ItemCount":0,"FundsType":{}},{"TypeCode":"060","Amount":"000","money":{"amount":"0.00","currency":"USD"},"ItemCount":0,"FundsType":{}},{"TypeCode":"100","Amount":"000","money":{"amount":"0.00","currency":"USD"},"ItemCount":0,"FundsType":{}},{"TypeCode":"400","Amount":"000","money":{"amount":"0.00","currency":"USD"},"ItemCount":0,"FundsType":{}}],"accountControlTotal":"000","numberRecords":2,"Details":null}]}]}
 `)
 	file := client.NewNullableFile(nil)
 	err = file.UnmarshalJSON(recorder.Body.Bytes())
@@ -305,7 +305,7 @@ func (suite *HandlersTest) TestFormat_Bai2FileWithSlashInText_Issue113() {
 
 	suite.testServer.ServeHTTP(recorder, request)
 	assert.Equal(suite.T(), http.StatusOK, recorder.Code)
-	assert.Equal(suite.T(), recorder.Body.String(), `{"sender":"GSBI","receiver":"cont001","fileCreatedDate":"210706","fileCreatedTime":"1249","fileIdNumber":"1","versionNumber":2,"fileControlTotal":"13060195162","numberOfGroups":1,"numberOfRecords":18,"Groups":[{"receiver":"cont001","originator":"026015079","groupStatus":1,"asOfDate":"230906","asOfTime":"2000","groupControlTotal":"13060195162","numberOfAccounts":4,"numberOfRecords":16,"Accounts":[{"accountNumber":"107049924","currencyCode":"USD","summaries":[{"TypeCode":"","Amount":"","ItemCount":0,"FundsType":{}},{"TypeCode":"060","Amount":"13053325440","ItemCount":0,"FundsType":{}},{"TypeCode":"100","Amount":"000","ItemCount":0,"FundsType":{}},{"TypeCode":"400","Amount":"000","ItemCount":0,"FundsType":{}}],"accountControlTotal":"13053325440","numberRecords":2,"Details":null},{"accountNumber":"107049932","currencyCode":"USD","summaries":[{"TypeCode":"","Amount":"","ItemCount":0,"FundsType":{}},{"TypeCode":"060","Amount":"6865898","ItemCount":0,"FundsType":{}},{"TypeCode":"100","Amount":"1912","ItemCount":1,"FundsType":{}},{"TypeCode":"400","Amount":"000","ItemCount":0,"FundsType":{}}],"accountControlTotal":"-1260161341762","numberRecords":26,"Details":[{"TypeCode":"447","Amount":"60000","FundsType":{},"BankReferenceNumber":"SPB2322984714570","CustomerReferenceNumber":"1111","Text":"ACH Credit Payment,Entry Description: EXP; -, SEC: CCD, Client Ref ID: 1111, GS ID: SPB2322984714570,EREF: 1111,DBNM: TEST INC,CACT: ACHCONTROLOUTUSD01/"},{"TypeCode":"261","Amount":"143500","FundsType":{},"BankReferenceNumber":"SB2322600000404","CustomerReferenceNumber":"GSQ4FBGFDGWGKY","Text":"ACH Credit Reject,From: TEST INC, Remittance Info: \"ACH- Test - Addenda Record\", Entry Description: TRADE; -, SEC: CTX, Client Ref ID: GSQ4FBGFDGWGKY, GS ID: SB2322600000404,CREF: ,REMI: ACH- Test - Addenda Record,EREF: GSQ4FBGFDGWGKY,CRNM: Test,DBNM: SAMPLE INC,DACT: 101152046,DABA: 026015079/"},{"TypeCode":"447","Amount":"928650","FundsType":{},"BankReferenceNumber":"SPB2322684598521","CustomerReferenceNumber":"AB/GS/RPFILERP0001/RPBA0001","Text":"ACH Credit Payment,Entry Description: TRADE; -, SEC: CTX, Client Ref ID: AB/GS/TEST0001/RPBA0001, GS ID: SPB2322684598521,EREF: AB/GS/RPFILERP0001/RPBA0001,DBNM: SAMPLE INC,CACT: ACHCONTROLOUTUSD01/"}]},{"accountNumber":"104108339","currencyCode":"USD","summaries":[{"TypeCode":"010","Amount":"159581194","ItemCount":0,"FundsType":{}},{"TypeCode":"015","Amount":"159381194","ItemCount":0,"FundsType":{}},{"TypeCode":"040","Amount":"158568897","ItemCount":0,"FundsType":{}},{"TypeCode":"045","Amount":"158368897","ItemCount":0,"FundsType":{}},{"TypeCode":"100","Amount":"000","ItemCount":0,"FundsType":{}},{"TypeCode":"400","Amount":"200000","ItemCount":1,"FundsType":{}}],"accountControlTotal":"6869722","numberRecords":8,"Details":[{"TypeCode":"557","Amount":"200000","FundsType":{},"BankReferenceNumber":"SB2322600000214","CustomerReferenceNumber":"021000080000030","Text":"ACH Credit Receipt Return,Return To: Test, Remittance Info: \"SB2322300000052\", Entry Description: EXP; -, SEC: CCD, Reason: \"R02\", Return of Client Ref ID: 021000080000030, GS ID: SB2322600000214,CREF: 026015076104300,IDNM: 1114,EREF: 021000080000030,CRNM: Test,DBNM: SAMPLE INC.,CABA: 021000089/"},{"TypeCode":"451","Amount":"55555","FundsType":{},"BankReferenceNumber":"SB2322600000455","CustomerReferenceNumber":"021000020000021","Text":"ACH Debit Payment,To: TEST, Entry Description: INVOICES; 210630, SEC: CCD, Client Ref ID: 021000020000021, GS ID: SB2322600000455,CREF: 021000020000021,IDNM: 2009282,EREF: 021000020000021,CRNM: TEST,DBNM: SAMPLE INC,CABA: 021000021/"},{"TypeCode":"266","Amount":"1912","FundsType":{},"BankReferenceNumber":"GI2118700002010","CustomerReferenceNumber":"20210706MMQFMPU8000001","Text":"Outgoing Wire Return,-,CREF: 20210706MMQFMPU8000001,EREF: 20210706MMQFMPU8000001,DBIC: GSCRUS33,CRNM: ABC Company,DBNM: SAMPLE INC./"},{"TypeCode":"495","Amount":"50500","FundsType":{},"BankReferenceNumber":"GI2321400000090","CustomerReferenceNumber":"GSV0DL6RKT","Text":"Outgoing Wire,To: TEST COMPANY, Remittance Info: \"QWERTIOP\", Client Ref ID: GSV0DL6RKT, GS ID: GI2321400000090, Settled Amt: EUR 322.00, FX Rate: 156.833677,REMI: QWERTIOP,EREF: GSV0DL6RKT,CBIC: COBADEFF,CRNM: TEST COMPANY,DBNM: SAMPLE TEST/"},{"TypeCode":"195","Amount":"1125","FundsType":{},"BankReferenceNumber":"GI2229300000187","CustomerReferenceNumber":"GS0D9VGMP1IWPLW","Text":"Incoming Wire,-,EREF: GS0D9VGMP1IWPLW,DBIC: CITIUS30XXX,CRNM: ABC CORPORATION,DACT: 8348572423,CHKN: GSIL2X6103UNCRSF/"},{"TypeCode":"257","Amount":"60000","FundsType":{},"BankReferenceNumber":"SB2225800001203","CustomerReferenceNumber":"028000020000335","Text":"ACH Debit Payment Return,Return From: Company1, Entry Description: TRADE; -, SEC: CCD, Reason: \"R02\", Return of Client Ref ID: 028000020000335, GS ID: SB2225800001203,IDNM: 1,EREF: 028000020000335,CRNM: TEST INC,DBNM: Company1,DABA: 028000024/"},{"TypeCode":"255","Amount":"931","FundsType":{},"BankReferenceNumber":"SC2134800001999","CustomerReferenceNumber":"","Text":"Check Return,Return From: Test2 Customer, Check Serial Number: 0009000000, Return Reason: \"Payee does not exist\", Client Ref ID: 74564762445, GS ID: SC21348000012099988:EREF: 07370568132,CRNM: Test Inc.,DBNM: Test2 Customer,CABA: 12345,CHKN: 0009000000/"},{"TypeCode":"195","Amount":"50050","FundsType":{},"BankReferenceNumber":"GI2228400005800","CustomerReferenceNumber":"RTR60880840833","Text":"RTP Incoming,From: SAMPLE INC, Remittance Info: \"Test Remittance\", Client Ref ID: RTR60880840833, GS ID: GI2228400005800, Clearing Ref: 001,REMI: Test Remittance,EREF: RTR60880840833,CRNM: RTR-CdtrName,DBNM: SAMPLE INC,DACT: 02122056789012205,DABA: 000000010/"},{"TypeCode":"175","Amount":"527","FundsType":{},"BankReferenceNumber":"SX22293073766088","CustomerReferenceNumber":"GS4N04L1COP45VY","Text":"Check Deposit,-,EREF: GS4N04L1COP45VY,DACT: 100168723/"},{"TypeCode":"475","Amount":"10100","FundsType":{},"BankReferenceNumber":"SC2229300000152","CustomerReferenceNumber":"01030340329","Text":"Check Paid,-,REMI: UAT testing for Checks,EREF: 01030340329,CRNM: TEST INC,DBNM: ABC CORP,CABA: 12345,CHKN: 006034594478/"},{"TypeCode":"275","Amount":"337686","FundsType":{},"BankReferenceNumber":"GI2318000014342","CustomerReferenceNumber":"e457328416d411eeaf020a58a9feac02","Text":"Cash Concentration,From: SAMPLE INC, Account: 290000020437, GS Cash Concentration, \"Structure ID: CC0000000\", GS ID: GI2318000212121,REMI: Structure ID: CC0000082,EREF: e123456786d411eeaf020a58a9feac02,DBIC: GSCRUS33VIA,CRNM: SAMPLE INC,DBNM: SAMPLE INC,DACT: 290000020437/"},{"TypeCode":"165","Amount":"5000","FundsType":{},"BankReferenceNumber":"SPB2321284264201","CustomerReferenceNumber":"AB/GS/DDFILEAB0001/DDBAB0001","Text":"ACH Debit Collection,Entry Description: BILL PMT; -, SEC: CCD, Client Ref ID: AB/GS/DDFILEAB0001/DDBAB0001, GS ID: SPB2321284264201,EREF: AB/GS/DDFILEAB0001/DDBAB0001,CRNM: SAMPLE LLP,DACT: ACHCONTROLINUSD01/"},{"TypeCode":"475","Amount":"44250","FundsType":{},"BankReferenceNumber":"SC2323300002416","CustomerReferenceNumber":"8ce1829175a74ec88d67010dd7fb6132","Text":"Check Paid,To: TEST AND COMPANY LLC, Check Serial Number: 24108, GS ID: SC2323300002416,EREF: 8ce1829175a74ec88d67010dd7fb6132,CRNM: TEST AND COMPANY LLC,DBNM: Sample Inc.,CABA: 0,CHKN: 24108/"},{"TypeCode":"495","Amount":"30000000","FundsType":{},"BankReferenceNumber":"GI2323300009168","CustomerReferenceNumber":"3785726","Text":"Outgoing Wire,To: TEST AND COMPANY, Remittance Info: \"08/18/23 Invoice - Sample\", Client Ref ID: 3785726, GS ID: GI2323300009168, Clearing Ref: 20230821MMQFMPU7004100,CREF: 20230821MMQFMPU7004100,REMI: 08/18/23 Invoice - Sample,EREF: 3785726,CRNM: TEST AND COMPANY,DBNM: Sample Inc.,CACT: 609873838,CABA: 021000021/"},{"TypeCode":"195","Amount":"3797999624","FundsType":{},"BankReferenceNumber":"GI2323300007089","CustomerReferenceNumber":"20230821J1Q5040C000707","Text":"Incoming Wire,From: TEST AND COMPANY, Client Ref ID: 20230821J1Q5040C000707, GS ID: GI2323300007089, Clearing Ref: 20230821J1Q5040C000707,CREF: 20230821J1Q5040C000707,EREF: 20230821J1Q5040C000707,CRNM: SAMPLE INC,DBNM: TEST AND COMPANY,DACT: 000001000600427/"},{"TypeCode":"698","Amount":"463462","FundsType":{},"BankReferenceNumber":"M8916_20230818_001","CustomerReferenceNumber":"M8916_20230818_001","Text":"Fees,Fees For Account: XXXXXXXX-0186/"},{"TypeCode":"354","Amount":"1764","FundsType":{},"BankReferenceNumber":"SBD85710_20230731_0021","CustomerReferenceNumber":"SBD85710_20230731_0021","Text":"Interest,Interest For Account: XXXXXXXX-3074, Period: Jul 1, 2023 to Jul 31, 2023/"}]},{"accountNumber":"260000033037","currencyCode":"USD","summaries":[{"TypeCode":"","Amount":"","ItemCount":0,"FundsType":{}},{"TypeCode":"060","Amount":"000","ItemCount":0,"FundsType":{}},{"TypeCode":"100","Amount":"000","ItemCount":0,"FundsType":{}},{"TypeCode":"400","Amount":"000","ItemCount":0,"FundsType":{}}],"accountControlTotal":"000","numberRecords":2,"Details":null},{"accountNumber":"280000010657","currencyCode":"USD","summaries":[{"TypeCode":"","Amount":"","ItemCount":0,"FundsType":{}},{"TypeCode":"060","Amount":"000","ItemCount":0,"FundsType":{}},{"TypeCode":"100","Amount":"000","ItemCount":0,"FundsType":{}},{"TypeCode":"400","Amount":"000","ItemCount":0,"FundsType":{}}],"accountControlTotal":"000","numberRecords":2,"Details":null}]}]}
+	assert.Equal(suite.T(), recorder.Body.String(), `{"sender":"GSBI","receiver":"cont001","fileCreatedDate":"210706","fileCreatedTime":"1249","fileIdNumber":"1","versionNumber":2,"fileControlTotal":"13060195162","numberOfGroups":1,"numberOfRecords":18,"Groups":[{"receiver":"cont001","originator":"026015079","groupStatus":1,"asOfDate":"230906","asOfTime":"2000","groupControlTotal":"13060195162","numberOfAccounts":4,"numberOfRecords":16,"Accounts":[{"accountNumber":"107049924","currencyCode":"USD","summaries":[{"TypeCode":"","Amount":"","ItemCount":0,"FundsType":{}},{"TypeCode":"060","Amount":"13053325440","money":{"amount":"130533254.40","currency":"USD"},"ItemCount":0,"FundsType":{}},{"TypeCode":"100","Amount":"000","money":{"amount":"0.00","currency":"USD"},"ItemCount":0,"FundsType":{}},{"TypeCode":"400","Amount":"000","money":{"amount":"0.00","currency":"USD"},"ItemCount":0,"FundsType":{}}],"accountControlTotal":"13053325440","numberRecords":2,"Details":null},{"accountNumber":"107049932","currencyCode":"USD","summaries":[{"TypeCode":"","Amount":"","ItemCount":0,"FundsType":{}},{"TypeCode":"060","Amount":"6865898","money":{"amount":"68658.98","currency":"USD"},"ItemCount":0,"FundsType":{}},{"TypeCode":"100","Amount":"1912","money":{"amount":"19.12","currency":"USD"},"ItemCount":1,"FundsType":{}},{"TypeCode":"400","Amount":"000","money":{"amount":"0.00","currency":"USD"},"ItemCount":0,"FundsType":{}}],"accountControlTotal":"-1260161341762","numberRecords":26,"Details":[{"TypeCode":"447","Amount":"60000","money":{"amount":"600.00","currency":"USD"},"FundsType":{},"BankReferenceNumber":"SPB2322984714570","CustomerReferenceNumber":"1111","Text":"ACH Credit Payment,Entry Description: EXP; -, SEC: CCD, Client Ref ID: 1111, GS ID: SPB2322984714570,EREF: 1111,DBNM: TEST INC,CACT: ACHCONTROLOUTUSD01/"},{"TypeCode":"261","Amount":"143500","money":{"amount":"1435.00","currency":"USD"},"FundsType":{},"BankReferenceNumber":"SB2322600000404","CustomerReferenceNumber":"GSQ4FBGFDGWGKY","Text":"ACH Credit Reject,From: TEST INC, Remittance Info: \"ACH- Test - Addenda Record\", Entry Description: TRADE; -, SEC: CTX, Client Ref ID: GSQ4FBGFDGWGKY, GS ID: SB2322600000404,CREF: ,REMI: ACH- Test - Addenda Record,EREF: GSQ4FBGFDGWGKY,CRNM: Test,DBNM: SAMPLE INC,DACT: 101152046,DABA: 026015079/"},{"TypeCode":"447","Amount":"928650","money":{"amount":"9286.50","currency":"USD"},"FundsType":{},"BankReferenceNumber":"SPB2322684598521","CustomerReferenceNumber":"AB/GS/RPFILERP0001/RPBA0001","Text":"ACH Credit Payment,Entry Description: TRADE; -, SEC: CTX, Client Ref ID: AB/GS/TEST0001/RPBA0001, GS ID: SPB2322684598521,EREF: AB/GS/RPFILERP0001/RPBA0001,DBNM: SAMPLE INC,CACT: ACHCONTROLOUTUSD01/"}]},{"accountNumber":"104108339","currencyCode":"USD","summaries":[{"TypeCode":"010","Amount":"159581194","money":{"amount":"1595811.94","currency":"USD"},"ItemCount":0,"FundsType":{}},{"TypeCode":"015","Amount":"159381194","money":{"amount":"1593811.94","currency":"USD"},"ItemCount":0,"FundsType":{}},{"TypeCode":"040","Amount":"158568897","money":{"amount":"1585688.97","currency":"USD"},"ItemCount":0,"FundsType":{}},{"TypeCode":"045","Amount":"158368897","money":{"amount":"1583688.97","currency":"USD"},"ItemCount":0,"FundsType":{}},{"TypeCode":"100","Amount":"000","money":{"amount":"0.00","currency":"USD"},"ItemCount":0,"FundsType":{}},{"TypeCode":"400","Amount":"200000","money":{"amount":"2000.00","currency":"USD"},"ItemCount":1,"FundsType":{}}],"accountControlTotal":"6869722","numberRecords":8,"Details":[{"TypeCode":"557","Amount":"200000","money":{"amount":"2000.00","currency":"USD"},"FundsType":{},"BankReferenceNumber":"SB2322600000214","CustomerReferenceNumber":"021000080000030","Text":"ACH Credit Receipt Return,Return To: Test, Remittance Info: \"SB2322300000052\", Entry Description: EXP; -, SEC: CCD, Reason: \"R02\", Return of Client Ref ID: 021000080000030, GS ID: SB2322600000214,CREF: 026015076104300,IDNM: 1114,EREF: 021000080000030,CRNM: Test,DBNM: SAMPLE INC.,CABA: 021000089/"},{"TypeCode":"451","Amount":"55555","money":{"amount":"555.55","currency":"USD"},"FundsType":{},"BankReferenceNumber":"SB2322600000455","CustomerReferenceNumber":"021000020000021","Text":"ACH Debit Payment,To: TEST, Entry Description: INVOICES; 210630, SEC: CCD, Client Ref ID: 021000020000021, GS ID: SB2322600000455,CREF: 021000020000021,IDNM: 2009282,EREF: 021000020000021,CRNM: TEST,DBNM: SAMPLE INC,CABA: 021000021/"},{"TypeCode":"266","Amount":"1912","money":{"amount":"19.12","currency":"USD"},"FundsType":{},"BankReferenceNumber":"GI2118700002010","CustomerReferenceNumber":"20210706MMQFMPU8000001","Text":"Outgoing Wire Return,-,CREF: 20210706MMQFMPU8000001,EREF: 20210706MMQFMPU8000001,DBIC: GSCRUS33,CRNM: ABC Company,DBNM: SAMPLE INC./"},{"TypeCode":"495","Amount":"50500","money":{"amount":"505.00","currency":"USD"},"FundsType":{},"BankReferenceNumber":"GI2321400000090","CustomerReferenceNumber":"GSV0DL6RKT","Text":"Outgoing Wire,To: TEST COMPANY, Remittance Info: \"QWERTIOP\", Client Ref ID: GSV0DL6RKT, GS ID: GI2321400000090, Settled Amt: EUR 322.00, FX Rate: 156.833677,REMI: QWERTIOP,EREF: GSV0DL6RKT,CBIC: COBADEFF,CRNM: TEST COMPANY,DBNM: SAMPLE TEST/"},{"TypeCode":"195","Amount":"1125","money":{"amount":"11.25","currency":"USD"},"FundsType":{},"BankReferenceNumber":"GI2229300000187","CustomerReferenceNumber":"GS0D9VGMP1IWPLW","Text":"Incoming Wire,-,EREF: GS0D9VGMP1IWPLW,DBIC: CITIUS30XXX,CRNM: ABC CORPORATION,DACT: 8348572423,CHKN: GSIL2X6103UNCRSF/"},{"TypeCode":"257","Amount":"60000","money":{"amount":"600.00","currency":"USD"},"FundsType":{},"BankReferenceNumber":"SB2225800001203","CustomerReferenceNumber":"028000020000335","Text":"ACH Debit Payment Return,Return From: Company1, Entry Description: TRADE; -, SEC: CCD, Reason: \"R02\", Return of Client Ref ID: 028000020000335, GS ID: SB2225800001203,IDNM: 1,EREF: 028000020000335,CRNM: TEST INC,DBNM: Company1,DABA: 028000024/"},{"TypeCode":"255","Amount":"931","money":{"amount":"9.31","currency":"USD"},"FundsType":{},"BankReferenceNumber":"SC2134800001999","CustomerReferenceNumber":"","Text":"Check Return,Return From: Test2 Customer, Check Serial Number: 0009000000, Return Reason: \"Payee does not exist\", Client Ref ID: 74564762445, GS ID: SC21348000012099988:EREF: 07370568132,CRNM: Test Inc.,DBNM: Test2 Customer,CABA: 12345,CHKN: 0009000000/"},{"TypeCode":"195","Amount":"50050","money":{"amount":"500.50","currency":"USD"},"FundsType":{},"BankReferenceNumber":"GI2228400005800","CustomerReferenceNumber":"RTR60880840833","Text":"RTP Incoming,From: SAMPLE INC, Remittance Info: \"Test Remittance\", Client Ref ID: RTR60880840833, GS ID: GI2228400005800, Clearing Ref: 001,REMI: Test Remittance,EREF: RTR60880840833,CRNM: RTR-CdtrName,DBNM: SAMPLE INC,DACT: 02122056789012205,DABA: 000000010/"},{"TypeCode":"175","Amount":"527","money":{"amount":"5.27","currency":"USD"},"FundsType":{},"BankReferenceNumber":"SX22293073766088","CustomerReferenceNumber":"GS4N04L1COP45VY","Text":"Check Deposit,-,EREF: GS4N04L1COP45VY,DACT: 100168723/"},{"TypeCode":"475","Amount":"10100","money":{"amount":"101.00","currency":"USD"},"FundsType":{},"BankReferenceNumber":"SC2229300000152","CustomerReferenceNumber":"01030340329","Text":"Check Paid,-,REMI: UAT testing for Checks,EREF: 01030340329,CRNM: TEST INC,DBNM: ABC CORP,CABA: 12345,CHKN: 006034594478/"},{"TypeCode":"275","Amount":"337686","money":{"amount":"3376.86","currency":"USD"},"FundsType":{},"BankReferenceNumber":"GI2318000014342","CustomerReferenceNumber":"e457328416d411eeaf020a58a9feac02","Text":"Cash Concentration,From: SAMPLE INC, Account: 290000020437, GS Cash Concentration, \"Structure ID: CC0000000\", GS ID: GI2318000212121,REMI: Structure ID: CC0000082,EREF: e123456786d411eeaf020a58a9feac02,DBIC: GSCRUS33VIA,CRNM: SAMPLE INC,DBNM: SAMPLE INC,DACT: 290000020437/"},{"TypeCode":"165","Amount":"5000","money":{"amount":"50.00","currency":"USD"},"FundsType":{},"BankReferenceNumber":"SPB2321284264201","CustomerReferenceNumber":"AB/GS/DDFILEAB0001/DDBAB0001","Text":"ACH Debit Collection,Entry Description: BILL PMT; -, SEC: CCD, Client Ref ID: AB/GS/DDFILEAB0001/DDBAB0001, GS ID: SPB2321284264201,EREF: AB/GS/DDFILEAB0001/DDBAB0001,CRNM: SAMPLE LLP,DACT: ACHCONTROLINUSD01/"},{"TypeCode":"475","Amount":"44250","money":{"amount":"442.50","currency":"USD"},"FundsType":{},"BankReferenceNumber":"SC2323300002416","CustomerReferenceNumber":"8ce1829175a74ec88d67010dd7fb6132","Text":"Check Paid,To: TEST AND COMPANY LLC, Check Serial Number: 24108, GS ID: SC2323300002416,EREF: 8ce1829175a74ec88d67010dd7fb6132,CRNM: TEST AND COMPANY LLC,DBNM: Sample Inc.,CABA: 0,CHKN: 24108/"},{"TypeCode":"495","Amount":"30000000","money":{"amount":"300000.00","currency":"USD"},"FundsType":{},"BankReferenceNumber":"GI2323300009168","CustomerReferenceNumber":"3785726","Text":"Outgoing Wire,To: TEST AND COMPANY, Remittance Info: \"08/18/23 Invoice - Sample\", Client Ref ID: 3785726, GS ID: GI2323300009168, Clearing Ref: 20230821MMQFMPU7004100,CREF: 20230821MMQFMPU7004100,REMI: 08/18/23 Invoice - Sample,EREF: 3785726,CRNM: TEST AND COMPANY,DBNM: Sample Inc.,CACT: 609873838,CABA: 021000021/"},{"TypeCode":"195","Amount":"3797999624","money":{"amount":"37979996.24","currency":"USD"},"FundsType":{},"BankReferenceNumber":"GI2323300007089","CustomerReferenceNumber":"20230821J1Q5040C000707","Text":"Incoming Wire,From: TEST AND COMPANY, Client Ref ID: 20230821J1Q5040C000707, GS ID: GI2323300007089, Clearing Ref: 20230821J1Q5040C000707,CREF: 20230821J1Q5040C000707,EREF: 20230821J1Q5040C000707,CRNM: SAMPLE INC,DBNM: TEST AND COMPANY,DACT: 000001000600427/"},{"TypeCode":"698","Amount":"463462","money":{"amount":"4634.62","currency":"USD"},"FundsType":{},"BankReferenceNumber":"M8916_20230818_001","CustomerReferenceNumber":"M8916_20230818_001","Text":"Fees,Fees For Account: XXXXXXXX-0186/"},{"TypeCode":"354","Amount":"1764","money":{"amount":"17.64","currency":"USD"},"FundsType":{},"BankReferenceNumber":"SBD85710_20230731_0021","CustomerReferenceNumber":"SBD85710_20230731_0021","Text":"Interest,Interest For Account: XXXXXXXX-3074, Period: Jul 1, 2023 to Jul 31, 2023/"}]},{"accountNumber":"260000033037","currencyCode":"USD","summaries":[{"TypeCode":"","Amount":"","ItemCount":0,"FundsType":{}},{"TypeCode":"060","Amount":"000","money":{"amount":"0.00","currency":"USD"},"ItemCount":0,"FundsType":{}},{"TypeCode":"100","Amount":"000","money":{"amount":"0.00","currency":"USD"},"ItemCount":0,"FundsType":{}},{"TypeCode":"400","Amount":"000","money":{"amount":"0.00","currency":"USD"},"ItemCount":0,"FundsType":{}}],"accountControlTotal":"000","numberRecords":2,"Details":null},{"accountNumber":"280000010657","currencyCode":"USD","summaries":[{"TypeCode":"","Amount":"","ItemCount":0,"FundsType":{}},{"TypeCode":"060","Amount":"000","money":{"amount":"0.00","currency":"USD"},"ItemCount":0,"FundsType":{}},{"TypeCode":"100","Amount":"000","money":{"amount":"0.00","currency":"USD"},"ItemCount":0,"FundsType":{}},{"TypeCode":"400","Amount":"000","money":{"amount":"0.00","currency":"USD"},"ItemCount":0,"FundsType":{}}],"accountControlTotal":"000","numberRecords":2,"Details":null}]}]}
 `)
 	file := client.NewNullableFile(nil)
 	err = file.UnmarshalJSON(recorder.Body.Bytes())
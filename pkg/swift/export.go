@@ -0,0 +1,270 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package swift
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/moov-io/bai2/pkg/lib"
+)
+
+/*
+
+EXPORT
+
+Every account of a file is reported as an MT940 statement, or as an MT942 report when its group holds intraday
+data:
+
+	File header         the file identification number as :20: and as statement number of :28C:, which is 1
+	                    when the identification number is not numeric
+	Group header        the as-of date as the date of balances and the entry date of transactions, and the
+	                    as-of date and time as :13D: of MT942 reports
+	Account identifier  the account number as :25:, and the count of the statements of the account so far as
+	                    sequence number of :28C:
+	Status type codes   010 as :60F:, 015 as :62F: and 045 as :64:. Without 010, :60F: is the 015 less the net
+	                    amount of the :61: lines, and without 015, :62F: is the 010 plus that amount.
+	Transaction detail  :61: with the transaction type of the type code, the customer reference number, or NONREF,
+	                    as account owner reference and the bank reference number as account servicing institution
+	                    reference. Value dated funds types set the value date. The text is written in :86:.
+
+MT942 reports have a floor limit of zero, and the number and sum of their debits and credits in :90D: and :90C:.
+Transaction details that are neither credits nor debits are not reported, and references are cut to 16
+characters. Text is cut between characters, never inside the UTF-8 encoding of one.
+
+*/
+
+// Option configures the conversion of BAI2 files and MT940 and MT942 messages
+type Option func(*converter)
+
+// WithTransactionTypes sets the mapping of type codes and transaction types, rather than the default mapping
+func WithTransactionTypes(types *TransactionTypes) Option {
+	return func(c *converter) {
+		c.types = types
+	}
+}
+
+// WithImportOptions configures the sender, receiver and registries of imported files. The sender, which is also
+// the originator of their groups, and the receiver are required to import statements.
+func WithImportOptions(opts ...lib.ImportOption) Option {
+	return func(c *converter) {
+		c.imports = append(c.imports, opts...)
+	}
+}
+
+type converter struct {
+	lib.ImportConfig
+
+	types   *TransactionTypes
+	imports []lib.ImportOption
+}
+
+func newConverter(opts []Option) *converter {
+	c := &converter{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.ImportConfig = lib.NewImportConfig(c.imports...)
+	if c.types == nil {
+		c.types = NewTransactionTypes()
+	}
+	return c
+}
+
+// NewStatements returns the MT940 statements of the accounts of the end-of-day groups of the file, and the MT942
+// reports of the accounts of its intraday groups
+func NewStatements(file *lib.Bai2, opts ...Option) ([]Statement, error) {
+	c := newConverter(opts)
+
+	number := int64(1)
+	if n, err := strconv.ParseInt(file.FileIdNumber, 10, 64); err == nil && n > 0 {
+		number = n
+	}
+
+	var statements []Statement
+	// sequence numbers of :28C: restart for every account
+	sequences := make(map[string]int)
+	for i := range file.Groups {
+		group := &file.Groups[i]
+
+		asOf, err := group.AsOf()
+		if err != nil {
+			return nil, fmt.Errorf("%v in group %d", err, i+1)
+		}
+		day, err := group.AsOfDay()
+		if err != nil {
+			return nil, fmt.Errorf("%v in group %d", err, i+1)
+		}
+
+		for j := range group.Accounts {
+			statement, err := c.newStatement(group, &group.Accounts[j], day, asOf)
+			if err != nil {
+				return nil, fmt.Errorf("%v in group %d", err, i+1)
+			}
+			statement.TransactionReference = truncate(file.FileIdNumber, referenceLength)
+			sequences[statement.Account]++
+			statement.StatementNumber = fmt.Sprintf("%d/%d", number, sequences[statement.Account])
+
+			statements = append(statements, statement)
+		}
+	}
+
+	return statements, nil
+}
+
+// newStatement returns the balances and transactions of the statement or report of an account. Balances and
+// transactions are dated on the as-of date, which an end of day as-of time does not move to the following day,
+// while reports are dated at the as-of time.
+func (c *converter) newStatement(group *lib.Group, account *lib.Account, day, asOf time.Time) (Statement, error) {
+	currency := account.EffectiveCurrency(group)
+
+	statement := Statement{
+		MessageType: MT940,
+		Account:     account.AccountNumber,
+	}
+
+	net := lib.NewMoney(0, currency)
+	debits := Totals{Amount: lib.NewMoney(0, currency)}
+	credits := Totals{Amount: lib.NewMoney(0, currency)}
+
+	for i := range account.Details {
+		detail := &account.Details[i]
+
+		transaction, ok, err := c.newTransaction(detail, group, currency, day)
+		if err != nil {
+			return Statement{}, fmt.Errorf("%v for account %s", err, account.AccountNumber)
+		}
+		if !ok {
+			continue
+		}
+		statement.Transactions = append(statement.Transactions, transaction)
+
+		totals := &credits
+		amount := transaction.Amount
+		if transaction.Direction() == lib.DirectionDebit {
+			totals = &debits
+			amount = amount.Neg()
+		}
+		totals.Count++
+		totals.Amount.Amount += transaction.Amount.Amount
+		net.Amount += amount.Amount
+	}
+
+	if group.AsOfDateModifier.Intraday() {
+		floorLimit := lib.NewMoney(0, currency)
+		statement.MessageType = MT942
+		statement.FloorLimit = &floorLimit
+		statement.DateTime = asOf
+		statement.Debits = &debits
+		statement.Credits = &credits
+		return statement, nil
+	}
+
+	balances := make(map[string]*Balance)
+	for _, s := range account.Summaries {
+		switch s.TypeCode {
+		case lib.TypeCodeOpeningLedger, lib.TypeCodeClosingLedger, lib.TypeCodeClosingAvailable:
+		default:
+			continue
+		}
+		if s.Amount == "" {
+			continue
+		}
+
+		amount, err := s.Money(currency)
+		if err != nil {
+			return Statement{}, fmt.Errorf("%v for account %s", err, account.AccountNumber)
+		}
+		balances[s.TypeCode] = &Balance{Date: day.Format("060102"), Amount: amount}
+	}
+
+	opening, closing := balances[lib.TypeCodeOpeningLedger], balances[lib.TypeCodeClosingLedger]
+	switch {
+	case opening == nil && closing == nil:
+		return Statement{}, fmt.Errorf("no opening or closing ledger for account %s", account.AccountNumber)
+	case opening == nil:
+		opening = &Balance{Date: closing.Date, Amount: lib.NewMoney(closing.Amount.Amount-net.Amount, currency)}
+	case closing == nil:
+		closing = &Balance{Date: opening.Date, Amount: lib.NewMoney(opening.Amount.Amount+net.Amount, currency)}
+	}
+
+	statement.OpeningBalance = opening
+	statement.ClosingBalance = closing
+	statement.ClosingAvailable = balances[lib.TypeCodeClosingAvailable]
+
+	return statement, nil
+}
+
+// newTransaction returns the statement line of a transaction detail, which is not found when the detail is
+// neither a credit nor a debit
+func (c *converter) newTransaction(detail *lib.Detail, group *lib.Group, currency string, day time.Time) (Transaction, bool, error) {
+	direction := group.TypeCodeDirection(detail.TypeCode)
+	if direction == lib.DirectionNone {
+		return Transaction{}, false, nil
+	}
+
+	amount, err := detail.Money(currency)
+	if err != nil {
+		return Transaction{}, false, err
+	}
+
+	transaction := Transaction{
+		ValueDate:         day.Format("060102"),
+		Mark:              Credit,
+		Amount:            amount,
+		TransactionType:   c.types.TransactionType(detail.TypeCode),
+		CustomerReference: truncate(detail.CustomerReferenceNumber, referenceLength),
+		BankReference:     truncate(detail.BankReferenceNumber, referenceLength),
+		Information:       information(strings.TrimSpace(strings.TrimSuffix(detail.Text, "/"))),
+	}
+	if direction == lib.DirectionDebit {
+		transaction.Mark = Debit
+	}
+	if amount.Amount < 0 {
+		transaction.Mark = "R" + transaction.Mark
+		transaction.Amount = amount.Neg()
+	}
+	if transaction.CustomerReference == "" {
+		transaction.CustomerReference = NoReference
+	}
+
+	if strings.EqualFold(string(detail.FundsType.TypeCode), lib.FundsTypeV) {
+		// the value date is validated, and kept as dated when its time is the end of the day
+		if _, err := detail.FundsType.ValueDate(day.Location()); err != nil {
+			return Transaction{}, false, err
+		}
+		if detail.FundsType.Date != transaction.ValueDate {
+			transaction.ValueDate = detail.FundsType.Date
+			transaction.EntryDate = day.Format("0102")
+		}
+	}
+
+	return transaction, true, nil
+}
+
+// information returns the text split into lines of the information to the account owner, the text being
+// cut when it does not fit
+func information(text string) string {
+	var lines []string
+	for text != "" && len(lines) < informationLines {
+		line := truncate(text, informationLength)
+		lines = append(lines, line)
+		text = text[len(line):]
+	}
+	return strings.Join(lines, "\n")
+}
+
+// truncate cuts a value to its first length characters
+func truncate(value string, length int) string {
+	for i := range value {
+		if length == 0 {
+			return value[:i]
+		}
+		length--
+	}
+	return value
+}
@@ -0,0 +1,195 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package swift
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/bai2/pkg/lib"
+)
+
+// statementSample is an end-of-day group with an account reporting an opening ledger and a closing available
+// balance, followed by an intraday group with a JPY account
+const statementSample = `01,0004,12345,060318,1100,001,,,2/
+02,12345,0004,1,060317,0000,USD,2/
+03,10200123456,,010,100000,,,045,160000,,/
+16,115,90000,,1234567,,LOCK BOX NO.68751/
+16,142,2500,V,060320,,,INV-1,/
+16,555,2500,,,,/
+16,890,,,,,NOTICE/
+49,355000,6/
+98,355000,1,8/
+02,12345,0004,1,060318,1030,USD,3/
+03,10200123457,JPY,,,,/
+16,475,12345,,CHK1001,1001,/
+16,195,50000,,,,/
+49,62345,4/
+98,62345,1,6/
+99,417345,2,16/`
+
+func TestNewStatements(t *testing.T) {
+	scan := lib.NewBai2Scanner(strings.NewReader(statementSample))
+	file := lib.NewBai2()
+	require.NoError(t, file.Read(&scan))
+
+	statements, err := NewStatements(file)
+	require.NoError(t, err)
+	require.Len(t, statements, 2)
+
+	// the closing ledger is derived from the opening ledger and the transactions
+	statement := statements[0]
+	require.Equal(t, MT940, statement.MessageType)
+	require.Equal(t, &Balance{Date: "060317", Amount: lib.NewMoney(190000, "USD")}, statement.ClosingBalance)
+	require.Len(t, statement.Transactions, 3)
+	require.Equal(t, lib.DirectionCredit, statement.Transactions[0].Direction())
+
+	report := statements[1]
+	require.Equal(t, MT942, report.MessageType)
+	require.Equal(t, &Totals{Count: 1, Amount: lib.NewMoney(12345, "JPY")}, report.Debits)
+
+	var buf strings.Builder
+	require.NoError(t, Write(&buf, statements))
+	require.Equal(t, `:20:001
+:25:10200123456
+:28C:1/1
+:60F:C060317USD1000,00
+:61:060317C900,00NCOLNONREF//1234567
+:86:LOCK BOX NO.68751
+:61:0603200317C25,00NTRFINV-1
+:61:060317D25,00NRTINONREF
+:62F:C060317USD1900,00
+:64:C060317USD1600,00
+-
+:20:001
+:25:10200123457
+:28C:1/1
+:34F:JPY0,
+:13D:0603181030+0000
+:61:060318D12345,NCHK1001//CHK1001
+:61:060318C50000,NTRFNONREF
+:90D:1JPY12345,
+:90C:1JPY50000,
+-
+`, buf.String())
+
+	// the sequence number counts the statements of the account
+	file.Groups[1].Accounts[0].AccountNumber = "10200123456"
+	statements, err = NewStatements(file)
+	require.NoError(t, err)
+	require.Equal(t, "1/1", statements[0].StatementNumber)
+	require.Equal(t, "1/2", statements[1].StatementNumber)
+}
+
+func TestNewStatements_Sample(t *testing.T) {
+	fd, err := os.Open(filepath.Join("..", "..", "test", "testdata", "sample2.txt"))
+	require.NoError(t, err)
+	defer fd.Close()
+
+	scan := lib.NewBai2Scanner(fd)
+	file := lib.NewBai2()
+	require.NoError(t, file.Read(&scan))
+
+	statements, err := NewStatements(file)
+	require.NoError(t, err)
+	require.Len(t, statements, 5)
+
+	var buf strings.Builder
+	require.NoError(t, Write(&buf, statements[:3]))
+	require.Equal(t, `:20:1
+:25:0123456789
+:28C:1/1
+:60F:C040620USD43500,00
+:61:040620C4500,00NCOLNONREF
+:62F:C040620USD48000,00
+-
+:20:1
+:25:9876543210
+:28C:1/1
+:60F:D040620USD5000,00
+:61:040620C5000,00NCOLNONREF
+:86:LOCK BOX NO.68751
+:62F:C040620USD0,00
+-
+:20:1
+:25:4589761203
+:28C:1/1
+:60F:C040620USD100000,00
+:61:0406220620C200000,00NMSCYRC065321//SP4738
+:86:PROCEEDS OF LETTER OF CREDIT FROM THE ARAMCO OIL CO
+:61:040620C100000,00NTRFNONREF
+:62F:C040620USD400000,00
+-
+`, buf.String())
+}
+
+func TestNewStatementsEndOfDay(t *testing.T) {
+	// the end of day as-of time 9999 still dates balances and transactions on the as-of date, and a value
+	// date at the end of the as-of date is not a later value date
+	scan := lib.NewBai2Scanner(strings.NewReader(strings.Replace(statementSample, "060317,0000", "060317,9999", 1)))
+	file := lib.NewBai2()
+	require.NoError(t, file.Read(&scan))
+	file.Groups[0].Accounts[0].Details[1].FundsType.Date = "060317"
+	file.Groups[0].Accounts[0].Details[1].FundsType.Time = "2400"
+
+	statements, err := NewStatements(file)
+	require.NoError(t, err)
+
+	statement := statements[0]
+	require.Equal(t, "060317", statement.OpeningBalance.Date)
+	require.Equal(t, "060317", statement.ClosingBalance.Date)
+	require.Equal(t, "060317", statement.ClosingAvailable.Date)
+	require.Equal(t, "060317C25,00NTRFINV-1", statement.Transactions[1].String())
+}
+
+func TestNewStatementsReversal(t *testing.T) {
+	scan := lib.NewBai2Scanner(strings.NewReader(statementSample))
+	file := lib.NewBai2()
+	require.NoError(t, file.Read(&scan))
+	account := &file.Groups[0].Accounts[0]
+	account.Details[0].Amount = "-90000"
+	account.Details[0].Text = strings.Repeat("0123456789", 40)
+	require.NoError(t, file.Finalize())
+
+	statements, err := NewStatements(file)
+	require.NoError(t, err)
+
+	// a negative credit is the reversal of a credit, and the text is cut after six lines
+	transaction := statements[0].Transactions[0]
+	require.Equal(t, ReversalCredit, transaction.Mark)
+	require.Equal(t, lib.DirectionDebit, transaction.Direction())
+	require.Equal(t, "060317RC900,00NCOLNONREF//1234567", transaction.String())
+	require.Len(t, strings.Split(transaction.Information, "\n"), informationLines)
+
+	// text and references are cut between characters
+	account.Details[0].Text = strings.Repeat("é", 70)
+	account.Details[0].BankReferenceNumber = strings.Repeat("ü", 20)
+	statements, err = NewStatements(file)
+	require.NoError(t, err)
+	transaction = statements[0].Transactions[0]
+	require.Equal(t, strings.Repeat("é", 65)+"\n"+strings.Repeat("é", 5), transaction.Information)
+	require.Equal(t, strings.Repeat("ü", 16), transaction.BankReference)
+	require.Equal(t, lib.NewMoney(10000, "USD"), statements[0].ClosingBalance.Amount)
+}
+
+func TestNewStatementsErrors(t *testing.T) {
+	scan := lib.NewBai2Scanner(strings.NewReader(statementSample))
+	file := lib.NewBai2()
+	require.NoError(t, file.Read(&scan))
+	file.Groups[0].Accounts[0].Summaries[0].TypeCode = lib.TypeCodeCurrentLedger
+	_, err := NewStatements(file)
+	require.EqualError(t, err, "no opening or closing ledger for account 10200123456 in group 1")
+
+	scan = lib.NewBai2Scanner(strings.NewReader(statementSample))
+	file = lib.NewBai2()
+	require.NoError(t, file.Read(&scan))
+	file.Groups[1].Accounts[0].Details[0].Amount = "1.5"
+	_, err = NewStatements(file)
+	require.EqualError(t, err, `invalid amount "1.5" for account 10200123457 in group 2`)
+}
@@ -0,0 +1,227 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package swift
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/moov-io/bai2/pkg/lib"
+	"github.com/moov-io/bai2/pkg/util"
+)
+
+/*
+
+IMPORT
+
+MT940 statements and MT942 reports are converted into a finalized BAI2 file, in the reverse of NewStatements:
+
+	:20:             the transaction reference of the first message as file identification number
+	:25:             the account number. Statements of the same date, and reports of the same date and time,
+	                 make up a group with the sender as originator.
+	:60F: and :62F:  010 and 015, the date of the closing balance, or else of the opening balance, being the
+	                 as-of date of final previous-day data
+	:64:             045
+	:13D:            the as-of date and time of interim same-day data
+	:90C: and :90D:  100 and 400 with their number of entries
+	:61:             transaction details with the type code of the transaction type and direction, the
+	                 account servicing institution reference as bank reference number and the account owner
+	                 reference, unless NONREF, as customer reference number. Value dates later than the as-of
+	                 date set a value dated funds type.
+	:86:             the text of the transaction detail of the preceding statement line
+
+The sender and receiver of the file need to be configured by WithImportOptions, and the file is created as of the
+latest as-of date and time of its groups. References are imported with their commas and slashes dropped, since a
+BAI2 field cannot hold them.
+
+*/
+
+// ReadStatements reads MT940 and MT942 messages and converts them into a BAI2 file
+func ReadStatements(r io.Reader, opts ...Option) (*lib.Bai2, error) {
+	statements, err := Read(r)
+	if err != nil {
+		return nil, err
+	}
+	return ImportStatements(statements, opts...)
+}
+
+// ImportStatements converts MT940 statements and MT942 reports into a BAI2 file
+func ImportStatements(statements []Statement, opts ...Option) (*lib.Bai2, error) {
+	c := newConverter(opts)
+	if c.Sender == "" {
+		return nil, errors.New("sender of the file is not configured")
+	}
+	if c.Receiver == "" {
+		return nil, errors.New("receiver of the file is not configured")
+	}
+	if len(statements) == 0 {
+		return nil, errors.New("no statement to import")
+	}
+
+	type groupKey struct {
+		modifier lib.AsOfDateModifier
+		asOf     time.Time
+	}
+	var keys []groupKey
+	groups := make(map[groupKey][]*Statement)
+
+	var created time.Time
+	for i := range statements {
+		statement := &statements[i]
+
+		modifier := lib.AsOfFinalPreviousDay
+		if statement.MessageType == MT942 {
+			modifier = lib.AsOfInterimSameDay
+		}
+		asOf, err := statementAsOf(statement, c.TimeZones().Location(c.Sender))
+		if err != nil {
+			return nil, fmt.Errorf("%v in message %d", err, i+1)
+		}
+		if asOf.After(created) {
+			created = asOf
+		}
+
+		key := groupKey{modifier: modifier, asOf: asOf}
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], statement)
+	}
+
+	builder := lib.NewFileBuilder().
+		Registries(c.RegistryOptions()...).
+		Sender(c.Sender).
+		Receiver(c.Receiver).
+		Created(created).
+		FileID(statements[0].TransactionReference)
+
+	for _, key := range keys {
+		statements := groups[key]
+
+		builder.Group(c.Sender, key.asOf).AsOfDateModifier(key.modifier)
+
+		groupCurrency := statements[0].Currency()
+		if groupCurrency != "" {
+			builder.GroupCurrency(groupCurrency)
+		}
+
+		for _, statement := range statements {
+			if err := c.importAccount(builder, statement, groupCurrency, key.asOf); err != nil {
+				return nil, fmt.Errorf("%v for account %s", err, statement.Account)
+			}
+		}
+	}
+
+	return builder.Build()
+}
+
+// importAccount adds the account of the statement, with its balances, totals and transactions
+func (c *converter) importAccount(builder *lib.FileBuilder, statement *Statement, groupCurrency string, asOf time.Time) error {
+	builder.Account(statement.Account)
+
+	if currency := statement.Currency(); currency != "" && currency != groupCurrency {
+		builder.AccountCurrency(currency)
+	}
+
+	balances := []struct {
+		typeCode string
+		balance  *Balance
+	}{
+		{lib.TypeCodeOpeningLedger, statement.OpeningBalance},
+		{lib.TypeCodeClosingLedger, statement.ClosingBalance},
+		{lib.TypeCodeClosingAvailable, statement.ClosingAvailable},
+	}
+	for _, b := range balances {
+		if b.balance != nil {
+			builder.Summary(b.typeCode, b.balance.Amount.Amount, 0)
+		}
+	}
+
+	totals := []struct {
+		typeCode string
+		totals   *Totals
+	}{
+		{lib.TypeCodeTotalCredits, statement.Credits},
+		{lib.TypeCodeTotalDebits, statement.Debits},
+	}
+	for _, t := range totals {
+		if t.totals != nil {
+			builder.Summary(t.typeCode, t.totals.Amount.Amount, t.totals.Count)
+		}
+	}
+
+	for i := range statement.Transactions {
+		if err := c.importTransaction(builder, &statement.Transactions[i], asOf); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// importTransaction adds the transaction detail of a statement line
+func (c *converter) importTransaction(builder *lib.FileBuilder, transaction *Transaction, asOf time.Time) error {
+	direction := transaction.Direction()
+	if direction == lib.DirectionNone {
+		return fmt.Errorf("invalid mark %q", transaction.Mark)
+	}
+	typeCode := c.types.originatorTypeCode(c.TypeCodes(), c.Sender, transaction.TransactionType, direction)
+
+	var opts []lib.DetailOption
+	if reference := util.SanitizeField(transaction.BankReference); reference != "" {
+		opts = append(opts, lib.WithBankReference(reference))
+	}
+	if transaction.CustomerReference != NoReference {
+		if reference := util.SanitizeField(transaction.CustomerReference); reference != "" {
+			opts = append(opts, lib.WithCustomerReference(reference))
+		}
+	}
+	if text := strings.TrimSpace(transaction.Information); text != "" {
+		opts = append(opts, lib.WithText(text))
+	}
+
+	valueDate, err := time.ParseInLocation("060102", transaction.ValueDate, asOf.Location())
+	if err != nil {
+		return fmt.Errorf("invalid value date %q", transaction.ValueDate)
+	}
+	if valueDate.After(asOf) && valueDate.Format("060102") != asOf.Format("060102") {
+		opts = append(opts, lib.WithFundsType(lib.FundsType{TypeCode: lib.FundsTypeV, Date: transaction.ValueDate}))
+	}
+
+	if direction == lib.DirectionDebit {
+		builder.Debit(typeCode, transaction.Amount.Amount, opts...)
+	} else {
+		builder.Credit(typeCode, transaction.Amount.Amount, opts...)
+	}
+	return nil
+}
+
+// statementAsOf returns the date and time of a report, or the date of the closing or opening balance of a
+// statement in the location
+func statementAsOf(statement *Statement, location *time.Location) (time.Time, error) {
+	if statement.MessageType == MT942 {
+		if statement.DateTime.IsZero() {
+			return time.Time{}, errors.New("missing field :13D:")
+		}
+		return statement.DateTime, nil
+	}
+
+	balance := statement.ClosingBalance
+	if balance == nil {
+		balance = statement.OpeningBalance
+	}
+	if balance == nil {
+		return time.Time{}, errors.New("missing field :62F:")
+	}
+
+	asOf, err := time.ParseInLocation("060102", balance.Date, location)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q", balance.Date)
+	}
+	return asOf, nil
+}
@@ -0,0 +1,200 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package swift
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/bai2/pkg/lib"
+)
+
+const sampleMT940 = "{1:F01COBADEFFAXXX0000000000}{2:O9401200060318COBADEFFAXXX00000000000603181200N}{4:\r\n" +
+	":20:STMT060317\r\n" +
+	":25:10020030/1234567\r\n" +
+	":28C:00071/001\r\n" +
+	":60F:C060316EUR1234,56\r\n" +
+	":61:0603170317C500,00NTRFINV-17//BNK-9\r\n" +
+	"TRANSFER FROM ACME\r\n" +
+	":86:PAYMENT OF INVOICE 17 FROM ACME CORP, THANK YOU FOR YOUR BUSIN\r\n" +
+	"ESS\r\n" +
+	":61:0603200317D34,56NCHKNONREF\r\n" +
+	":61:060317D100,NMSCNONREF//FEE,01\r\n" +
+	":62F:C060317EUR1600,\r\n" +
+	":64:D060317EUR10,\r\n" +
+	":86:STATEMENT INFORMATION\r\n" +
+	"-}{5:{CHK:123456789ABC}}\r\n"
+
+func TestRead(t *testing.T) {
+	statements, err := Read(strings.NewReader(sampleMT940))
+	require.NoError(t, err)
+	require.Len(t, statements, 1)
+
+	statement := statements[0]
+	require.Equal(t, MT940, statement.MessageType)
+	require.Equal(t, "STMT060317", statement.TransactionReference)
+	require.Equal(t, "10020030/1234567", statement.Account)
+	require.Equal(t, "00071/001", statement.StatementNumber)
+	require.Equal(t, &Balance{Date: "060316", Amount: lib.NewMoney(123456, "EUR")}, statement.OpeningBalance)
+	require.Equal(t, &Balance{Date: "060317", Amount: lib.NewMoney(-1000, "EUR")}, statement.ClosingAvailable)
+	require.Equal(t, "EUR", statement.Currency())
+	require.Equal(t, "STATEMENT INFORMATION", statement.Information)
+
+	require.Equal(t, []Transaction{
+		{
+			ValueDate: "060317", EntryDate: "0317", Mark: Credit, Amount: lib.NewMoney(50000, "EUR"), TransactionType: "NTRF",
+			CustomerReference: "INV-17", BankReference: "BNK-9", SupplementaryDetails: "TRANSFER FROM ACME",
+			Information: "PAYMENT OF INVOICE 17 FROM ACME CORP, THANK YOU FOR YOUR BUSINESS",
+		},
+		{ValueDate: "060320", EntryDate: "0317", Mark: Debit, Amount: lib.NewMoney(3456, "EUR"), TransactionType: "NCHK", CustomerReference: NoReference},
+		{ValueDate: "060317", Mark: Debit, Amount: lib.NewMoney(10000, "EUR"), TransactionType: "NMSC", CustomerReference: NoReference, BankReference: "FEE,01"},
+	}, statement.Transactions)
+}
+
+func TestReadErrors(t *testing.T) {
+	_, err := Read(strings.NewReader("TEXT\n"))
+	require.EqualError(t, err, `unexpected line "TEXT" in message 1`)
+
+	_, err = Read(strings.NewReader(":20:1\n:25:1\n:60F:C060316EUR12.00\n-\n"))
+	require.EqualError(t, err, `invalid balance "C060316EUR12.00" in field :60F: in message 1`)
+
+	_, err = Read(strings.NewReader(":20:1\n:25:1\n:60F:C060316EUR12,\n-\n:20:2\n:25:1\n:61:0603160316X1,NTRFNONREF\n-\n"))
+	require.EqualError(t, err, `invalid statement line "0603160316X1,NTRFNONREF" in field :61: in message 2`)
+
+	_, err = Read(strings.NewReader(":20:1\n:60F:C060316JPY12,5\n-\n"))
+	require.EqualError(t, err, `invalid amount "12.5" for currency JPY in field :60F: in message 1`)
+
+	_, err = Read(strings.NewReader(":20:1\n-\n"))
+	require.EqualError(t, err, "missing field :25: in message 1")
+}
+
+func TestReadStatements(t *testing.T) {
+	file, err := ReadStatements(strings.NewReader(sampleMT940), WithImportOptions(lib.ImportSender("COBADEFF"), lib.ImportReceiver("12345")))
+	require.NoError(t, err)
+
+	require.Equal(t, "COBADEFF", file.Sender)
+	require.Equal(t, "STMT060317", file.FileIdNumber)
+	require.Len(t, file.Groups, 1)
+
+	group := file.Groups[0]
+	require.Equal(t, "COBADEFF", group.Originator)
+	require.Equal(t, "060317", group.AsOfDate)
+	require.Equal(t, lib.AsOfFinalPreviousDay, group.AsOfDateModifier)
+	require.Equal(t, "EUR", group.CurrencyCode)
+
+	account := group.Accounts[0]
+	require.Equal(t, "10020030/1234567", account.AccountNumber)
+	require.Equal(t, []string{lib.TypeCodeOpeningLedger, lib.TypeCodeClosingLedger, lib.TypeCodeClosingAvailable},
+		[]string{account.Summaries[0].TypeCode, account.Summaries[1].TypeCode, account.Summaries[2].TypeCode})
+	require.Equal(t, "-1000", account.Summaries[2].Amount)
+
+	require.Len(t, account.Details, 3)
+	require.Equal(t, lib.TypeCodeIncomingMoneyTransfer, account.Details[0].TypeCode)
+	require.Equal(t, "BNK-9", account.Details[0].BankReferenceNumber)
+	require.Equal(t, "INV-17", account.Details[0].CustomerReferenceNumber)
+	require.Equal(t, "PAYMENT OF INVOICE 17 FROM ACME CORP, THANK YOU FOR YOUR BUSINESS", account.Details[0].Text)
+
+	// the check is value dated after the as-of date
+	require.Equal(t, lib.TypeCodeCheckPaid, account.Details[1].TypeCode)
+	require.Equal(t, lib.FundsType{TypeCode: lib.FundsTypeV, Date: "060320"}, account.Details[1].FundsType)
+	require.Empty(t, account.Details[1].CustomerReferenceNumber)
+
+	require.Equal(t, lib.TypeCodeMiscellaneousDebit, account.Details[2].TypeCode)
+	require.Equal(t, "FEE01", account.Details[2].BankReferenceNumber)
+
+	types := NewTransactionTypes()
+	require.NoError(t, types.Register("698", "NMSC"))
+	file, err = ReadStatements(strings.NewReader(sampleMT940), WithImportOptions(lib.ImportSender("COBADEFF"), lib.ImportReceiver("12345")), WithTransactionTypes(types))
+	require.NoError(t, err)
+	require.Equal(t, "698", file.Groups[0].Accounts[0].Details[2].TypeCode)
+
+	// customized type codes are read back in the direction they have for the sender in the registry of the import
+	registry := lib.NewTypeCodeRegistry()
+	require.NoError(t, registry.Register("COBADEFF", lib.TypeCodeDefinition{Code: "930", Name: "Service Fee", Category: lib.CategoryDetail, Direction: lib.DirectionDebit}))
+	types = NewTransactionTypes()
+	require.NoError(t, types.Register("930", "NMSC"))
+	file, err = ReadStatements(strings.NewReader(sampleMT940), WithImportOptions(lib.ImportSender("COBADEFF"), lib.ImportReceiver("12345")), WithTransactionTypes(types))
+	require.NoError(t, err)
+	require.Equal(t, lib.TypeCodeMiscellaneousDebit, file.Groups[0].Accounts[0].Details[2].TypeCode)
+
+	file, err = ReadStatements(strings.NewReader(sampleMT940), WithImportOptions(lib.ImportSender("COBADEFF"), lib.ImportReceiver("12345"), lib.ImportRegistries(lib.UseTypeCodes(registry))), WithTransactionTypes(types))
+	require.NoError(t, err)
+	require.Equal(t, "930", file.Groups[0].Accounts[0].Details[2].TypeCode)
+}
+
+func TestImportStatementsErrors(t *testing.T) {
+	statements, err := Read(strings.NewReader(sampleMT940))
+	require.NoError(t, err)
+
+	_, err = ImportStatements(statements, WithImportOptions(lib.ImportReceiver("12345")))
+	require.EqualError(t, err, "sender of the file is not configured")
+
+	_, err = ImportStatements(statements, WithImportOptions(lib.ImportSender("COBADEFF")))
+	require.EqualError(t, err, "receiver of the file is not configured")
+
+	_, err = ImportStatements(nil, WithImportOptions(lib.ImportSender("COBADEFF"), lib.ImportReceiver("12345")))
+	require.EqualError(t, err, "no statement to import")
+
+	statements[0].MessageType = MT942
+	_, err = ImportStatements(statements, WithImportOptions(lib.ImportSender("COBADEFF"), lib.ImportReceiver("12345")))
+	require.EqualError(t, err, "missing field :13D: in message 1")
+}
+
+func TestStatementsRoundTrip(t *testing.T) {
+	scan := lib.NewBai2Scanner(strings.NewReader(statementSample))
+	file := lib.NewBai2()
+	require.NoError(t, file.Read(&scan))
+
+	statements, err := NewStatements(file)
+	require.NoError(t, err)
+
+	var buf strings.Builder
+	require.NoError(t, Write(&buf, statements))
+
+	types := NewTransactionTypes()
+	require.NoError(t, types.Register(lib.TypeCodeACHCreditReceived, "NTRF"))
+
+	read, err := ReadStatements(strings.NewReader(buf.String()), WithImportOptions(lib.ImportSender("0004"), lib.ImportReceiver("12345")), WithTransactionTypes(types))
+	require.NoError(t, err)
+
+	// the closing ledger is reported, non-monetary information is not, MT942 reports carry their totals, the
+	// currency of the only account is the group currency, and incoming money transfers are read back as ACH
+	// credits by the registered mapping
+	scan = lib.NewBai2Scanner(strings.NewReader(statementSample))
+	expected := lib.NewBai2()
+	require.NoError(t, expected.Read(&scan))
+	expected.FileCreatedDate, expected.FileCreatedTime = "060318", "1030"
+	expected.Groups[0].AsOfTime = "0000"
+	accounts := expected.Groups[0].Accounts
+	accounts[0].Summaries = []lib.AccountSummary{
+		accounts[0].Summaries[0],
+		{TypeCode: lib.TypeCodeClosingLedger, Amount: "190000"},
+		accounts[0].Summaries[1],
+	}
+	accounts[0].Details = accounts[0].Details[:3]
+	expected.Groups[1].CurrencyCode = "JPY"
+	accounts = expected.Groups[1].Accounts
+	accounts[0].CurrencyCode = ""
+	accounts[0].Summaries = []lib.AccountSummary{
+		{TypeCode: lib.TypeCodeTotalCredits, Amount: "50000", ItemCount: 1},
+		{TypeCode: lib.TypeCodeTotalDebits, Amount: "12345", ItemCount: 1},
+	}
+	accounts[0].Details[1].TypeCode = lib.TypeCodeACHCreditReceived
+	require.NoError(t, expected.Finalize())
+
+	require.Equal(t, expected.String(), read.String())
+	require.Equal(t, time.Date(2006, time.March, 18, 10, 30, 0, 0, time.UTC), mustCreated(t, read))
+}
+
+func mustCreated(t *testing.T, file *lib.Bai2) time.Time {
+	t.Helper()
+
+	created, err := file.FileCreated()
+	require.NoError(t, err)
+	return created.UTC()
+}
@@ -0,0 +1,233 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package swift
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/moov-io/bai2/pkg/lib"
+)
+
+var (
+	fieldFormat       = regexp.MustCompile(`^:(\d{2}[A-Z]?):(.*)$`)
+	balanceFormat     = regexp.MustCompile(`^([CD])(\d{6})([A-Z]{3})(\d+,\d*)$`)
+	floorLimitFormat  = regexp.MustCompile(`^([A-Z]{3})[CD]?(\d+,\d*)$`)
+	totalsFormat      = regexp.MustCompile(`^(\d+)([A-Z]{3})(\d+,\d*)$`)
+	transactionFormat = regexp.MustCompile(`^(\d{6})(\d{4})?(RC|RD|C|D)[A-Z]?(\d+,\d*)([NSF][A-Z0-9]{3})(.*)$`)
+)
+
+// field is a tagged field of a message, whose continuation lines are separated by newlines
+type field struct {
+	tag, value string
+}
+
+// Read reads MT940 and MT942 messages. Messages may be wrapped in the basic, application and text blocks of
+// SWIFT FIN messages.
+func Read(r io.Reader) ([]Statement, error) {
+	var statements []Statement
+	var fields []field
+
+	end := func() error {
+		if len(fields) == 0 {
+			return nil
+		}
+		statement, err := parseStatement(fields)
+		if err != nil {
+			return fmt.Errorf("%v in message %d", err, len(statements)+1)
+		}
+		statements = append(statements, statement)
+		fields = nil
+		return nil
+	}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r ")
+
+		if strings.HasPrefix(line, "{") {
+			i := strings.Index(line, "{4:")
+			if i < 0 {
+				continue
+			}
+			line = line[i+len("{4:"):]
+		}
+
+		switch {
+		case line == "":
+		case line == "-" || strings.HasPrefix(line, "-}"):
+			if err := end(); err != nil {
+				return nil, err
+			}
+		case fieldFormat.MatchString(line):
+			m := fieldFormat.FindStringSubmatch(line)
+			fields = append(fields, field{tag: m[1], value: m[2]})
+		case len(fields) > 0:
+			fields[len(fields)-1].value += "\n" + line
+		default:
+			return nil, fmt.Errorf("unexpected line %q in message %d", line, len(statements)+1)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if err := end(); err != nil {
+		return nil, err
+	}
+
+	return statements, nil
+}
+
+// parseStatement returns the statement or report of the fields of a message. Statement lines are parsed
+// once the currency of the message is known.
+func parseStatement(fields []field) (Statement, error) {
+	statement := Statement{MessageType: MT940}
+
+	var lines []field
+	previous := ""
+	for _, f := range fields {
+		var err error
+
+		switch f.tag {
+		case "20":
+			statement.TransactionReference = f.value
+		case "21":
+			statement.RelatedReference = f.value
+		case "25":
+			statement.Account = f.value
+		case "28C":
+			statement.StatementNumber = f.value
+		case "60F", "60M":
+			statement.OpeningBalance, err = parseBalance(f.value)
+		case "62F", "62M":
+			statement.ClosingBalance, err = parseBalance(f.value)
+		case "64":
+			statement.ClosingAvailable, err = parseBalance(f.value)
+		case "34F":
+			statement.MessageType = MT942
+			statement.FloorLimit, err = parseFloorLimit(f.value)
+		case "13D":
+			statement.MessageType = MT942
+			statement.DateTime, err = time.Parse("0601021504-0700", f.value)
+		case "61":
+			lines = append(lines, f)
+		case "86":
+			if previous == "61" {
+				lines = append(lines, f)
+			} else {
+				statement.Information = strings.ReplaceAll(f.value, "\n", "")
+			}
+		case "90D":
+			statement.MessageType = MT942
+			statement.Debits, err = parseTotals(f.value)
+		case "90C":
+			statement.MessageType = MT942
+			statement.Credits, err = parseTotals(f.value)
+		}
+		if err != nil {
+			return Statement{}, fmt.Errorf("%v in field :%s:", err, f.tag)
+		}
+
+		previous = f.tag
+	}
+
+	if statement.Account == "" {
+		return Statement{}, fmt.Errorf("missing field :25:")
+	}
+
+	currency := statement.Currency()
+	for _, f := range lines {
+		if f.tag == "86" {
+			statement.Transactions[len(statement.Transactions)-1].Information = strings.ReplaceAll(f.value, "\n", "")
+			continue
+		}
+
+		transaction, err := parseTransaction(f.value, currency)
+		if err != nil {
+			return Statement{}, fmt.Errorf("%v in field :%s:", err, f.tag)
+		}
+		statement.Transactions = append(statement.Transactions, transaction)
+	}
+
+	return statement, nil
+}
+
+func parseBalance(value string) (*Balance, error) {
+	m := balanceFormat.FindStringSubmatch(value)
+	if m == nil {
+		return nil, fmt.Errorf("invalid balance %q", value)
+	}
+
+	amount, err := parseAmount(m[4], m[3])
+	if err != nil {
+		return nil, err
+	}
+	if m[1] == Debit {
+		amount = amount.Neg()
+	}
+	return &Balance{Date: m[2], Amount: amount}, nil
+}
+
+func parseFloorLimit(value string) (*lib.Money, error) {
+	m := floorLimitFormat.FindStringSubmatch(value)
+	if m == nil {
+		return nil, fmt.Errorf("invalid floor limit %q", value)
+	}
+
+	amount, err := parseAmount(m[2], m[1])
+	if err != nil {
+		return nil, err
+	}
+	return &amount, nil
+}
+
+func parseTotals(value string) (*Totals, error) {
+	m := totalsFormat.FindStringSubmatch(value)
+	if m == nil {
+		return nil, fmt.Errorf("invalid totals %q", value)
+	}
+
+	count, err := strconv.ParseInt(m[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid number of entries %q", m[1])
+	}
+	amount, err := parseAmount(m[3], m[2])
+	if err != nil {
+		return nil, err
+	}
+	return &Totals{Count: count, Amount: amount}, nil
+}
+
+// parseTransaction parses a statement line with its supplementary details
+func parseTransaction(value, currency string) (Transaction, error) {
+	line, supplementary, _ := strings.Cut(value, "\n")
+
+	m := transactionFormat.FindStringSubmatch(line)
+	if m == nil {
+		return Transaction{}, fmt.Errorf("invalid statement line %q", line)
+	}
+
+	amount, err := parseAmount(m[4], currency)
+	if err != nil {
+		return Transaction{}, err
+	}
+
+	transaction := Transaction{
+		ValueDate:            m[1],
+		EntryDate:            m[2],
+		Mark:                 m[3],
+		Amount:               amount,
+		TransactionType:      m[5],
+		SupplementaryDetails: supplementary,
+	}
+	transaction.CustomerReference, transaction.BankReference, _ = strings.Cut(m[6], "//")
+
+	return transaction, nil
+}
@@ -0,0 +1,261 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package swift
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/moov-io/bai2/pkg/lib"
+)
+
+/*
+
+SWIFT MT940 AND MT942
+
+MT940 customer statements report the booked transactions and balances of an account at the end of the day,
+and MT942 interim transaction reports the transactions of an account during the day. Messages are made of
+fields tagged :tag:, and end with a line holding a hyphen:
+
+	:20:   transaction reference number
+	:25:   account identification
+	:28C:  statement number and sequence number
+	:60F:  opening balance (MT940)
+	:34F:  floor limit, the smallest amount of the reported transactions (MT942)
+	:13D:  date and time of the report (MT942)
+	:61:   statement line of a transaction, followed by its information to the account owner in :86:
+	:62F:  closing balance (MT940)
+	:64:   closing available balance (MT940)
+	:90D:  number and sum of debits (MT942)
+	:90C:  number and sum of credits (MT942)
+
+Amounts are written in major units with a decimal comma, and dates as YYMMDD.
+
+*/
+
+// Message types
+const (
+	MT940 = "940"
+	MT942 = "942"
+)
+
+// Debit and credit marks of balances and statement lines. Reversal marks report the reversal of a credit,
+// which is a debit, or the reversal of a debit, which is a credit.
+const (
+	Credit         = "C"
+	Debit          = "D"
+	ReversalCredit = "RC"
+	ReversalDebit  = "RD"
+)
+
+// NoReference is the account owner reference of transactions without reference
+const NoReference = "NONREF"
+
+// maximum length of references and of the lines of the information to the account owner
+const (
+	referenceLength   = 16
+	informationLength = 65
+	informationLines  = 6
+)
+
+// Statement is an MT940 customer statement or an MT942 interim transaction report of an account
+type Statement struct {
+	MessageType          string
+	TransactionReference string
+	RelatedReference     string
+	Account              string
+	StatementNumber      string
+
+	// MT940 balances
+	OpeningBalance   *Balance
+	ClosingBalance   *Balance
+	ClosingAvailable *Balance
+
+	// MT942 floor limit and date and time
+	FloorLimit *lib.Money
+	DateTime   time.Time
+
+	Transactions []Transaction
+
+	// MT942 totals
+	Debits  *Totals
+	Credits *Totals
+
+	// information to the account owner about the whole statement
+	Information string
+}
+
+// Balance is a balance on a YYMMDD date, negative for debit balances
+type Balance struct {
+	Date   string
+	Amount lib.Money
+}
+
+// Totals is the number and sum of transactions
+type Totals struct {
+	Count  int64
+	Amount lib.Money
+}
+
+// Transaction is a statement line with its information to the account owner
+type Transaction struct {
+	// value date as YYMMDD and entry date as MMDD
+	ValueDate string
+	EntryDate string
+	// debit, credit or reversal mark
+	Mark string
+	// amount without sign
+	Amount          lib.Money
+	TransactionType string
+
+	CustomerReference    string
+	BankReference        string
+	SupplementaryDetails string
+	Information          string
+}
+
+// Direction returns whether the transaction is a credit or a debit to the account
+func (t Transaction) Direction() lib.TransactionDirection {
+	switch t.Mark {
+	case Credit, ReversalDebit:
+		return lib.DirectionCredit
+	case Debit, ReversalCredit:
+		return lib.DirectionDebit
+	}
+	return lib.DirectionNone
+}
+
+// Currency returns the currency of the balances, floor limit or totals of the statement
+func (s *Statement) Currency() string {
+	for _, b := range []*Balance{s.OpeningBalance, s.ClosingBalance, s.ClosingAvailable} {
+		if b != nil {
+			return b.Amount.Currency
+		}
+	}
+	if s.FloorLimit != nil {
+		return s.FloorLimit.Currency
+	}
+	for _, t := range []*Totals{s.Debits, s.Credits} {
+		if t != nil {
+			return t.Amount.Currency
+		}
+	}
+	return ""
+}
+
+// String returns the fields of the message, ending with a hyphen
+func (s *Statement) String() string {
+	var buf strings.Builder
+	field := func(tag, value string) {
+		buf.WriteString(":" + tag + ":" + value + "\n")
+	}
+
+	field("20", s.TransactionReference)
+	if s.RelatedReference != "" {
+		field("21", s.RelatedReference)
+	}
+	field("25", s.Account)
+	field("28C", s.StatementNumber)
+
+	if s.MessageType == MT942 {
+		if s.FloorLimit != nil {
+			field("34F", s.FloorLimit.Currency+formatAmount(*s.FloorLimit))
+		}
+		if !s.DateTime.IsZero() {
+			field("13D", s.DateTime.Format("0601021504-0700"))
+		}
+	} else if s.OpeningBalance != nil {
+		field("60F", s.OpeningBalance.String())
+	}
+
+	for _, t := range s.Transactions {
+		field("61", t.String())
+		if t.Information != "" {
+			field("86", t.Information)
+		}
+	}
+
+	if s.MessageType == MT942 {
+		if s.Debits != nil {
+			field("90D", s.Debits.String())
+		}
+		if s.Credits != nil {
+			field("90C", s.Credits.String())
+		}
+	} else {
+		if s.ClosingBalance != nil {
+			field("62F", s.ClosingBalance.String())
+		}
+		if s.ClosingAvailable != nil {
+			field("64", s.ClosingAvailable.String())
+		}
+	}
+
+	if s.Information != "" {
+		field("86", s.Information)
+	}
+
+	buf.WriteString("-")
+	return buf.String()
+}
+
+// String returns the mark, date, currency and amount of the balance
+func (b Balance) String() string {
+	mark := Credit
+	if b.Amount.Amount < 0 {
+		mark = Debit
+	}
+	return mark + b.Date + b.Amount.Currency + formatAmount(b.Amount)
+}
+
+// String returns the number, currency and sum of the transactions
+func (t Totals) String() string {
+	return fmt.Sprint(t.Count) + t.Amount.Currency + formatAmount(t.Amount)
+}
+
+// String returns the statement line of the transaction, with its supplementary details on a second line
+func (t Transaction) String() string {
+	line := t.ValueDate + t.EntryDate + t.Mark + formatAmount(t.Amount) + t.TransactionType + t.CustomerReference
+	if t.BankReference != "" {
+		line += "//" + t.BankReference
+	}
+	if t.SupplementaryDetails != "" {
+		line += "\n" + t.SupplementaryDetails
+	}
+	return line
+}
+
+// Write writes the messages of the statements
+func Write(w io.Writer, statements []Statement) error {
+	for i := range statements {
+		if _, err := io.WriteString(w, statements[i].String()+"\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// formatAmount returns the amount without sign in major units with a decimal comma, e.g. "1234,56"
+func formatAmount(amount lib.Money) string {
+	if amount.Amount < 0 {
+		amount = amount.Neg()
+	}
+	value := amount.Decimal()
+	if !strings.Contains(value, ".") {
+		return value + ","
+	}
+	return strings.Replace(value, ".", ",", 1)
+}
+
+// parseAmount parses an amount in major units with a decimal comma
+func parseAmount(value, currency string) (lib.Money, error) {
+	decimal := strings.TrimSuffix(strings.Replace(value, ",", ".", 1), ".")
+	if decimal == "" || strings.ContainsAny(decimal, "+-") {
+		return lib.Money{}, fmt.Errorf("invalid amount %q", value)
+	}
+	return lib.ParseDecimal(decimal, currency)
+}
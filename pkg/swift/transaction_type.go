@@ -0,0 +1,174 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package swift
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/moov-io/bai2/pkg/lib"
+	"github.com/moov-io/bai2/pkg/util"
+)
+
+/*
+
+TRANSACTION TYPES
+
+The statement lines of MT940 and MT942 messages identify the kind of a transaction by a SWIFT transaction type
+identification code, such as NTRF for transfers. By default BAI2 type codes are written as:
+
+	Transaction type  Type codes
+	NCOL              115 Lockbox Deposit
+	NTRF              142 ACH Credit Received, 145 ACH Concentration Credit, 165 Preauthorized ACH Credit,
+	                  195 Incoming Money Transfer, 206 Book Transfer Credit, 495 Outgoing Money Transfer,
+	                  506 Book Transfer Debit
+	NCHK              175 Check Deposit Package, 475 Check Paid
+	NCMI              275 ZBA Credit, 575 ZBA Debit
+	NINT              354 Interest Credit
+	NDDT              451 ACH Debit Received, 455 Preauthorized ACH Debit
+	NRTI              555 Deposited Item Returned
+	NCHG              698 Miscellaneous Fees
+	NMSC              other type codes
+
+and read back as:
+
+	Transaction type  Credit  Debit
+	NTRF              195     495
+	NCOL              115     699
+	NCHK              175     475
+	NCMI              275     575
+	NINT              354     699
+	NDDT              399     451
+	NRTI              399     555
+	NCHG              399     698
+	other types       399     699
+
+*/
+
+// transactionTypeFormat is the format of SWIFT transaction type identification codes
+var transactionTypeFormat = regexp.MustCompile(`^[NFS][A-Z0-9]{3}$`)
+
+// DefaultTransactionType is the transaction type of the type codes without mapping
+const DefaultTransactionType = "NMSC"
+
+// TransactionTypes maps BAI2 type codes to SWIFT transaction types and back. Mappings need to be registered
+// before conversions use them.
+type TransactionTypes struct {
+	transactionTypes map[string]string
+	typeCodes        map[directedType]string
+
+	// registered type codes, latest last, which are mapped back in the direction they have for the originator
+	registered []string
+}
+
+// directedType is a transaction type with the direction of its transactions
+type directedType struct {
+	transactionType string
+	direction       lib.TransactionDirection
+}
+
+// NewTransactionTypes returns the default mapping of type codes and transaction types
+func NewTransactionTypes() *TransactionTypes {
+	t := &TransactionTypes{
+		transactionTypes: map[string]string{
+			lib.TypeCodeLockboxDeposit:         "NCOL",
+			lib.TypeCodeACHCreditReceived:      "NTRF",
+			"145":                              "NTRF",
+			lib.TypeCodePreauthorizedACHCredit: "NTRF",
+			"175":                              "NCHK",
+			lib.TypeCodeIncomingMoneyTransfer:  "NTRF",
+			"206":                              "NTRF",
+			"275":                              "NCMI",
+			"354":                              "NINT",
+			lib.TypeCodeACHDebitReceived:       "NDDT",
+			lib.TypeCodePreauthorizedACHDebit:  "NDDT",
+			lib.TypeCodeCheckPaid:              "NCHK",
+			lib.TypeCodeOutgoingMoneyTransfer:  "NTRF",
+			"506":                              "NTRF",
+			"555":                              "NRTI",
+			"575":                              "NCMI",
+			"698":                              "NCHG",
+		},
+		typeCodes: make(map[directedType]string),
+	}
+
+	credits := map[string]string{
+		"NTRF": lib.TypeCodeIncomingMoneyTransfer,
+		"NCOL": lib.TypeCodeLockboxDeposit,
+		"NCHK": "175",
+		"NCMI": "275",
+		"NINT": "354",
+	}
+	debits := map[string]string{
+		"NTRF": lib.TypeCodeOutgoingMoneyTransfer,
+		"NCHK": lib.TypeCodeCheckPaid,
+		"NCMI": "575",
+		"NDDT": lib.TypeCodeACHDebitReceived,
+		"NRTI": "555",
+		"NCHG": "698",
+	}
+	for transactionType, typeCode := range credits {
+		t.typeCodes[directedType{transactionType, lib.DirectionCredit}] = typeCode
+	}
+	for transactionType, typeCode := range debits {
+		t.typeCodes[directedType{transactionType, lib.DirectionDebit}] = typeCode
+	}
+
+	return t
+}
+
+// Register maps a credit or debit type code to the transaction type, and the transactions of the type in the
+// direction of the type code back to the type code. The direction of customized type codes is the one they have
+// for the originator of the converted group, in the type code registry of the conversion.
+func (t *TransactionTypes) Register(typeCode, transactionType string) error {
+	if !util.ValidateTypeCode(typeCode) {
+		return fmt.Errorf("invalid type code %q", typeCode)
+	}
+	if !transactionTypeFormat.MatchString(transactionType) {
+		return fmt.Errorf("invalid transaction type %q", transactionType)
+	}
+
+	if definition, ok := lib.LookupTypeCode(typeCode); ok && !definition.Custom && definition.Direction == lib.DirectionNone {
+		return fmt.Errorf("type code %s is neither a credit nor a debit", typeCode)
+	}
+
+	t.transactionTypes[typeCode] = transactionType
+	t.registered = append(t.registered, typeCode)
+	return nil
+}
+
+// TransactionType returns the transaction type of a type code
+func (t *TransactionTypes) TransactionType(typeCode string) string {
+	if transactionType, ok := t.transactionTypes[typeCode]; ok {
+		return transactionType
+	}
+	return DefaultTransactionType
+}
+
+// TypeCode returns the type code of transactions of the transaction type in the direction, which are
+// miscellaneous credits or debits when the transaction type is not mapped. Customized type codes have the
+// direction registered in lib.CustomTypeCodes for any originator.
+func (t *TransactionTypes) TypeCode(transactionType string, direction lib.TransactionDirection) string {
+	return t.originatorTypeCode(lib.CustomTypeCodes, "", transactionType, direction)
+}
+
+// originatorTypeCode returns the type code of transactions of the transaction type in the direction, the direction
+// of registered type codes being the one they have for the originator in the registry
+func (t *TransactionTypes) originatorTypeCode(registry *lib.TypeCodeRegistry, originator, transactionType string, direction lib.TransactionDirection) string {
+	for i := len(t.registered) - 1; i >= 0; i-- {
+		typeCode := t.registered[i]
+		if t.transactionTypes[typeCode] == transactionType && registry.Direction(originator, typeCode) == direction {
+			return typeCode
+		}
+	}
+
+	if typeCode, ok := t.typeCodes[directedType{transactionType, direction}]; ok {
+		return typeCode
+	}
+	if direction == lib.DirectionDebit {
+		return lib.TypeCodeMiscellaneousDebit
+	}
+	return lib.TypeCodeMiscellaneousCredit
+}
@@ -0,0 +1,49 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package swift
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/bai2/pkg/lib"
+)
+
+func TestTransactionTypes(t *testing.T) {
+	types := NewTransactionTypes()
+
+	require.Equal(t, "NCOL", types.TransactionType(lib.TypeCodeLockboxDeposit))
+	require.Equal(t, "NTRF", types.TransactionType(lib.TypeCodeACHCreditReceived))
+	require.Equal(t, DefaultTransactionType, types.TransactionType("108"))
+
+	require.Equal(t, lib.TypeCodeIncomingMoneyTransfer, types.TypeCode("NTRF", lib.DirectionCredit))
+	require.Equal(t, lib.TypeCodeOutgoingMoneyTransfer, types.TypeCode("NTRF", lib.DirectionDebit))
+	require.Equal(t, lib.TypeCodeMiscellaneousCredit, types.TypeCode("NDDT", lib.DirectionCredit))
+	require.Equal(t, lib.TypeCodeMiscellaneousDebit, types.TypeCode("NMSC", lib.DirectionDebit))
+
+	require.NoError(t, types.Register(lib.TypeCodeACHCreditReceived, "NTRF"))
+	require.Equal(t, lib.TypeCodeACHCreditReceived, types.TypeCode("NTRF", lib.DirectionCredit))
+	require.Equal(t, lib.TypeCodeOutgoingMoneyTransfer, types.TypeCode("NTRF", lib.DirectionDebit))
+
+	require.NoError(t, types.Register("108", "NCLR"))
+	require.Equal(t, "NCLR", types.TransactionType("108"))
+	require.Equal(t, "108", types.TypeCode("NCLR", lib.DirectionCredit))
+
+	// registrations do not change other mappings
+	require.Equal(t, lib.TypeCodeIncomingMoneyTransfer, NewTransactionTypes().TypeCode("NTRF", lib.DirectionCredit))
+
+	require.EqualError(t, types.Register("12", "NTRF"), `invalid type code "12"`)
+	require.EqualError(t, types.Register("108", "XTRF"), `invalid transaction type "XTRF"`)
+	require.EqualError(t, types.Register(lib.TypeCodeOpeningLedger, "NMSC"), "type code 010 is neither a credit nor a debit")
+
+	// customized type codes take the direction they have for the originator
+	registry := lib.NewTypeCodeRegistry()
+	require.NoError(t, registry.Register("121000358", lib.TypeCodeDefinition{Code: "930", Category: lib.CategoryDetail, Direction: lib.DirectionDebit}))
+	require.NoError(t, types.Register("930", "NSWP"))
+	require.Equal(t, "930", types.TypeCode("NSWP", lib.DirectionCredit))
+	require.Equal(t, "930", types.originatorTypeCode(registry, "121000358", "NSWP", lib.DirectionDebit))
+	require.Equal(t, lib.TypeCodeMiscellaneousCredit, types.originatorTypeCode(registry, "121000358", "NSWP", lib.DirectionCredit))
+}
@@ -0,0 +1,273 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package tabular
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/moov-io/bai2/pkg/lib"
+)
+
+/*
+
+TABULAR EXPORT
+
+A file is flattened into a table of one row per transaction detail, or one row per account summary, written as
+delimiter separated values with a header row. The columns of a table are chosen from:
+
+	file_id             file identification number
+	sender              sender identification of the file
+	receiver            receiver identification of the file
+	group               number of the group in the file, starting at 1
+	originator          originator identification of the group
+	as_of_date          as-of date of the group, as YYYY-MM-DD
+	as_of_time          as-of time of the group, as HH:MM with 24:00 for the end of the day, empty when the group
+	                    has no as-of time
+	account             customer account number
+	currency            effective currency of the account
+	type_code           type code
+	type_code_name      name of the type code
+	direction           Credit, Debit or None
+	amount              amount in major units of the currency, negative for debits
+	item_count          item count of a summary
+	funds_type          funds type code
+	bank_reference      bank reference number of a detail
+	customer_reference  customer reference number of a detail
+	text                text of a detail
+
+Amounts that are not reported, such as the amount of non-monetary information, are left empty.
+
+*/
+
+// Delimiters of comma and tab separated values
+const (
+	Comma = ','
+	Tab   = '\t'
+)
+
+// row is a transaction detail or account summary with its account, group and file
+type row struct {
+	file     *lib.Bai2
+	group    *lib.Group
+	number   int
+	account  *lib.Account
+	asOf     time.Time
+	day      time.Time
+	currency string
+
+	typeCode  string
+	amount    string
+	itemCount int64
+	fundsType lib.FundsType
+	detail    *lib.Detail
+}
+
+var columns = map[string]func(r *row) (string, error){
+	"file_id":    func(r *row) (string, error) { return r.file.FileIdNumber, nil },
+	"sender":     func(r *row) (string, error) { return r.file.Sender, nil },
+	"receiver":   func(r *row) (string, error) { return r.file.Receiver, nil },
+	"group":      func(r *row) (string, error) { return strconv.Itoa(r.number), nil },
+	"originator": func(r *row) (string, error) { return r.group.Originator, nil },
+	"as_of_date": func(r *row) (string, error) { return r.day.Format("2006-01-02"), nil },
+	"as_of_time": func(r *row) (string, error) {
+		switch {
+		case r.group.AsOfTime == "":
+			return "", nil
+		case r.asOf.Equal(r.day.AddDate(0, 0, 1)):
+			// the end of the as-of date
+			return "24:00", nil
+		}
+		return r.asOf.Format("15:04"), nil
+	},
+	"account":   func(r *row) (string, error) { return r.account.AccountNumber, nil },
+	"currency":  func(r *row) (string, error) { return r.currency, nil },
+	"type_code": func(r *row) (string, error) { return r.typeCode, nil },
+	"type_code_name": func(r *row) (string, error) {
+		definition, _ := r.group.LookupTypeCode(r.typeCode)
+		return definition.Name, nil
+	},
+	"direction": func(r *row) (string, error) {
+		return r.group.TypeCodeDirection(r.typeCode).String(), nil
+	},
+	"amount": func(r *row) (string, error) {
+		if r.amount == "" {
+			return "", nil
+		}
+		amount, err := lib.ParseMoney(r.amount, r.currency)
+		if err != nil {
+			return "", err
+		}
+		if r.group.TypeCodeDirection(r.typeCode) == lib.DirectionDebit {
+			amount = amount.Neg()
+		}
+		return amount.Decimal(), nil
+	},
+	"item_count": func(r *row) (string, error) {
+		if r.detail != nil || r.itemCount == 0 {
+			return "", nil
+		}
+		return strconv.FormatInt(r.itemCount, 10), nil
+	},
+	"funds_type": func(r *row) (string, error) { return string(r.fundsType.TypeCode), nil },
+	"bank_reference": func(r *row) (string, error) {
+		if r.detail == nil {
+			return "", nil
+		}
+		return r.detail.BankReferenceNumber, nil
+	},
+	"customer_reference": func(r *row) (string, error) {
+		if r.detail == nil {
+			return "", nil
+		}
+		return r.detail.CustomerReferenceNumber, nil
+	},
+	"text": func(r *row) (string, error) {
+		if r.detail == nil {
+			return "", nil
+		}
+		return strings.TrimSpace(strings.TrimSuffix(r.detail.Text, "/")), nil
+	},
+}
+
+// DetailColumns returns the default columns of tables of transaction details
+func DetailColumns() []string {
+	return []string{
+		"file_id", "group", "originator", "account", "as_of_date", "currency",
+		"type_code", "type_code_name", "amount", "bank_reference", "customer_reference", "text",
+	}
+}
+
+// SummaryColumns returns the default columns of tables of account summaries
+func SummaryColumns() []string {
+	return []string{
+		"file_id", "group", "originator", "account", "as_of_date", "currency",
+		"type_code", "type_code_name", "amount", "item_count",
+	}
+}
+
+// Option configures the tables written by WriteDetails and WriteSummaries
+type Option func(*exporter)
+
+// WithDelimiter sets the delimiter of the values, which defaults to Comma
+func WithDelimiter(delimiter rune) Option {
+	return func(e *exporter) {
+		e.delimiter = delimiter
+	}
+}
+
+// WithColumns sets the columns of the table, rather than its default columns
+func WithColumns(columns ...string) Option {
+	return func(e *exporter) {
+		e.columns = columns
+	}
+}
+
+type exporter struct {
+	delimiter rune
+	columns   []string
+}
+
+// WriteDetails writes the table of the transaction details of the file
+func WriteDetails(w io.Writer, file *lib.Bai2, opts ...Option) error {
+	return write(w, file, DetailColumns(), opts, detailRows)
+}
+
+// WriteSummaries writes the table of the account summaries of the file
+func WriteSummaries(w io.Writer, file *lib.Bai2, opts ...Option) error {
+	return write(w, file, SummaryColumns(), opts, summaryRows)
+}
+
+// detailRows returns the rows of the transaction details of the account of a row
+func detailRows(account row) []row {
+	var rows []row
+	for i := range account.account.Details {
+		r := account
+		r.detail = &account.account.Details[i]
+		r.typeCode, r.amount, r.fundsType = r.detail.TypeCode, r.detail.Amount, r.detail.FundsType
+		rows = append(rows, r)
+	}
+	return rows
+}
+
+// summaryRows returns the rows of the account summaries of the account of a row
+func summaryRows(account row) []row {
+	var rows []row
+	for _, summary := range account.account.Summaries {
+		r := account
+		r.typeCode, r.amount, r.itemCount, r.fundsType = summary.TypeCode, summary.Amount, summary.ItemCount, summary.FundsType
+		rows = append(rows, r)
+	}
+	return rows
+}
+
+// write writes the header and the rows that accountRows returns for every account of the file
+func write(w io.Writer, file *lib.Bai2, defaultColumns []string, opts []Option, accountRows func(row) []row) error {
+	e := &exporter{delimiter: Comma, columns: defaultColumns}
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	values := make([]func(r *row) (string, error), len(e.columns))
+	for i, name := range e.columns {
+		value, ok := columns[name]
+		if !ok {
+			return fmt.Errorf("unknown column %q", name)
+		}
+		values[i] = value
+	}
+
+	writer := csv.NewWriter(w)
+	writer.Comma = e.delimiter
+	if err := writer.Write(e.columns); err != nil {
+		return err
+	}
+
+	for i := range file.Groups {
+		group := &file.Groups[i]
+
+		asOf, err := group.AsOf()
+		if err != nil {
+			return fmt.Errorf("%v in group %d", err, i+1)
+		}
+		day, err := group.AsOfDay()
+		if err != nil {
+			return fmt.Errorf("%v in group %d", err, i+1)
+		}
+
+		for j := range group.Accounts {
+			account := &group.Accounts[j]
+
+			rows := accountRows(row{
+				file:     file,
+				group:    group,
+				number:   i + 1,
+				account:  account,
+				asOf:     asOf,
+				day:      day,
+				currency: account.EffectiveCurrency(group),
+			})
+
+			for k := range rows {
+				record := make([]string, len(values))
+				for c, value := range values {
+					if record[c], err = value(&rows[k]); err != nil {
+						return fmt.Errorf("%v for account %s in group %d", err, account.AccountNumber, i+1)
+					}
+				}
+				if err := writer.Write(record); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
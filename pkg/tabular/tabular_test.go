@@ -0,0 +1,112 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package tabular
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/bai2/pkg/lib"
+)
+
+// tableSample is a group with a USD account reporting summaries, a credit, a debit and non-monetary
+// information, and a JPY account reporting a closing ledger
+const tableSample = `01,0004,12345,060321,0829,001,,,2/
+02,12345,0004,1,060317,0000,USD,/
+03,10200123456,,010,-100000,,,400,2500,1,/
+16,115,90000,,1234567,,LOCK BOX NO.68751, ACME/
+16,475,2500,1,,1001,/
+16,890,,,,,NOTICE/
+49,-5000,5/
+03,10200123457,JPY,015,500,,/
+49,500,2/
+98,-4500,2,9/
+99,-4500,1,11/`
+
+func TestWriteDetails(t *testing.T) {
+	scan := lib.NewBai2Scanner(strings.NewReader(tableSample))
+	file := lib.NewBai2()
+	require.NoError(t, file.Read(&scan))
+
+	var buf strings.Builder
+	require.NoError(t, WriteDetails(&buf, file))
+	require.Equal(t, `file_id,group,originator,account,as_of_date,currency,type_code,type_code_name,amount,bank_reference,customer_reference,text
+001,1,0004,10200123456,2006-03-17,USD,115,Lockbox Deposit,900.00,1234567,,"LOCK BOX NO.68751, ACME"
+001,1,0004,10200123456,2006-03-17,USD,475,Check Paid,-25.00,,1001,
+001,1,0004,10200123456,2006-03-17,USD,890,Contains Non-monetary Information,,,,NOTICE
+`, buf.String())
+
+	buf.Reset()
+	require.NoError(t, WriteDetails(&buf, file, WithDelimiter(Tab), WithColumns("account", "direction", "funds_type", "amount", "item_count")))
+	require.Equal(t, "account\tdirection\tfunds_type\tamount\titem_count\n"+
+		"10200123456\tCredit\t\t900.00\t\n"+
+		"10200123456\tDebit\t1\t-25.00\t\n"+
+		"10200123456\tNone\t\t\t\n", buf.String())
+}
+
+func TestWriteDetails_Sample(t *testing.T) {
+	fd, err := os.Open(filepath.Join("..", "..", "test", "testdata", "sample2.txt"))
+	require.NoError(t, err)
+	defer fd.Close()
+
+	scan := lib.NewBai2Scanner(fd)
+	file := lib.NewBai2()
+	require.NoError(t, file.Read(&scan))
+
+	var buf strings.Builder
+	require.NoError(t, WriteDetails(&buf, file, WithColumns("group", "account", "type_code", "amount", "bank_reference", "customer_reference")))
+	require.Equal(t, `group,account,type_code,amount,bank_reference,customer_reference
+1,0123456789,115,4500.00,,
+1,9876543210,115,5000.00,,
+2,4589761203,218,200000.00,SP4738,YRC065321
+2,4589761203,195,100000.00,,
+`, buf.String())
+}
+
+func TestWriteSummaries(t *testing.T) {
+	scan := lib.NewBai2Scanner(strings.NewReader(tableSample))
+	file := lib.NewBai2()
+	require.NoError(t, file.Read(&scan))
+
+	var buf strings.Builder
+	require.NoError(t, WriteSummaries(&buf, file))
+	require.Equal(t, `file_id,group,originator,account,as_of_date,currency,type_code,type_code_name,amount,item_count
+001,1,0004,10200123456,2006-03-17,USD,010,Opening Ledger,-1000.00,
+001,1,0004,10200123456,2006-03-17,USD,400,Total Debits,-25.00,1
+001,1,0004,10200123457,2006-03-17,JPY,015,Closing Ledger,500,
+`, buf.String())
+
+	buf.Reset()
+	require.NoError(t, WriteSummaries(&buf, file, WithColumns("sender", "receiver", "as_of_time", "text")))
+	require.Equal(t, "sender,receiver,as_of_time,text\n0004,12345,00:00,\n0004,12345,00:00,\n0004,12345,00:00,\n", buf.String())
+
+	// the end of day as-of time 9999 is the end of the as-of date rather than the next day
+	file.Groups[0].AsOfTime = "9999"
+	buf.Reset()
+	require.NoError(t, WriteSummaries(&buf, file, WithColumns("account", "as_of_date", "as_of_time")))
+	require.Equal(t, "account,as_of_date,as_of_time\n10200123456,2006-03-17,24:00\n10200123456,2006-03-17,24:00\n10200123457,2006-03-17,24:00\n", buf.String())
+}
+
+func TestWriteErrors(t *testing.T) {
+	scan := lib.NewBai2Scanner(strings.NewReader(tableSample))
+	file := lib.NewBai2()
+	require.NoError(t, file.Read(&scan))
+
+	var buf strings.Builder
+	require.EqualError(t, WriteDetails(&buf, file, WithColumns("account", "balance")), `unknown column "balance"`)
+
+	file.Groups[0].Accounts[0].Details[0].Amount = "9.00"
+	require.EqualError(t, WriteDetails(&buf, file), `invalid amount "9.00" for account 10200123456 in group 1`)
+
+	scan = lib.NewBai2Scanner(strings.NewReader(tableSample))
+	file = lib.NewBai2()
+	require.NoError(t, file.Read(&scan))
+	file.Groups[0].AsOfDate = "061317"
+	require.ErrorContains(t, WriteSummaries(&buf, file), "in group 1")
+}
@@ -6,8 +6,10 @@ package util
 
 import "regexp"
 
-var dateYYMMDDTypeRegex = regexp.MustCompile(`[0-9][0-9](0[1-9]|1[0-2])(0[1-9]|1[0-9]|2[0-9]|3[01])`)
-var timeTypeRegex = regexp.MustCompile(`[0-9][0-9][0-9][0-9]`)
+var dateYYMMDDTypeRegex = regexp.MustCompile(`^[0-9][0-9](0[1-9]|1[0-2])(0[1-9]|1[0-9]|2[0-9]|3[01])$`)
+
+// times are 0000 through 2400, some processors using 9999 for the end of the day
+var timeTypeRegex = regexp.MustCompile(`^(([01][0-9]|2[0-3])[0-5][0-9]|2400|9999)$`)
 var singedNumber = regexp.MustCompile(`^(-|\+|)?[0-9]\d*$`)
 var currencyCodeRegex = regexp.MustCompile(`^[a-zA-Z]{3}$`)
 var typeCodeRegex = regexp.MustCompile(`^[0-9]{3}$`)
@@ -0,0 +1,29 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTime(t *testing.T) {
+	for _, input := range []string{"0000", "0829", "2359", "2400", "9999"} {
+		require.True(t, ValidateTime(input), input)
+	}
+	for _, input := range []string{"", "2401", "2561", "0860", "829", "08290", "12:00"} {
+		require.False(t, ValidateTime(input), input)
+	}
+}
+
+func TestValidateDate(t *testing.T) {
+	for _, input := range []string{"060321", "991231", "000101"} {
+		require.True(t, ValidateDate(input), input)
+	}
+	for _, input := range []string{"", "061321", "060300", "0603211", "20060321"} {
+		require.False(t, ValidateDate(input), input)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"fmt"
 	"strings"
+	"unicode/utf8"
 )
 
 // WriteBuffer
@@ -22,7 +23,7 @@ func WriteBuffer(total, buf *bytes.Buffer, input string, maxLen int64) {
 
 		for _, elm := range elements {
 
-			newSize := int64(buf.Len() + len(newInput) + len(elm) + 2)
+			newSize := int64(utf8.RuneCount(buf.Bytes()) + utf8.RuneCountInString(newInput) + utf8.RuneCountInString(elm) + 2)
 			if newSize > maxLen {
 				if newInput == "" {
 					org := buf.String()
@@ -55,3 +56,9 @@ func WriteBuffer(total, buf *bytes.Buffer, input string, maxLen int64) {
 		buf.WriteString(input)
 	}
 }
+
+// SanitizeField removes the field delimiter "," and the record delimiter "/" from a value and trims its spaces,
+// so that the value can be written as a field of a record, such as a reference number
+func SanitizeField(value string) string {
+	return strings.TrimSpace(strings.NewReplacer(",", "", "/", "").Replace(value))
+}
@@ -0,0 +1,17 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSanitizeField(t *testing.T) {
+	require.Equal(t, "REF1234", SanitizeField(" REF,123/4 "))
+	require.Equal(t, "ABGS1", SanitizeField("AB/GS,1"))
+	require.Equal(t, "", SanitizeField("/"))
+}